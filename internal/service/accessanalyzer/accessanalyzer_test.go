@@ -18,10 +18,13 @@ func TestAccAccessAnalyzer_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"Analyzer": {
-			"basic":             testAccAnalyzer_basic,
-			"disappears":        testAccAnalyzer_disappears,
-			"tags":              testAccAnalyzer_tags,
-			"Type_Organization": testAccAnalyzer_Type_Organization,
+			"basic":                    testAccAnalyzer_basic,
+			"disappears":               testAccAnalyzer_disappears,
+			"tags":                     testAccAnalyzer_tags,
+			"Type_AccountUnusedAccess": testAccAnalyzer_Type_AccountUnusedAccess,
+			"Type_AccountUnusedAccessDefaultConfiguration": testAccAnalyzer_Type_AccountUnusedAccessDefaultConfiguration,
+			"Type_Organization":                            testAccAnalyzer_Type_Organization,
+			"Type_OrganizationUnusedAccess":                testAccAnalyzer_Type_OrganizationUnusedAccess,
 		},
 		"ArchiveRule": {
 			"basic":          testAccAnalyzerArchiveRule_basic,
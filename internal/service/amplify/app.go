@@ -170,7 +170,6 @@ func ResourceApp() *schema.Resource {
 			"custom_headers": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(1, 25000),
 			},
 			"custom_rule": {
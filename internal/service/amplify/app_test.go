@@ -330,6 +330,46 @@ func testAccApp_BuildSpec(t *testing.T) {
 	})
 }
 
+func testAccApp_CustomHeaders(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app amplify.App
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_amplify_app.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, amplify.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppConfig_customHeaders(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(ctx, resourceName, &app),
+					resource.TestMatchResourceAttr(resourceName, "custom_headers", regexache.MustCompile(`Strict-Transport-Security`)),
+					resource.TestMatchResourceAttr(resourceName, "custom_headers", regexache.MustCompile(`X-Frame-Options`)),
+				),
+			},
+			{
+				Config:   testAccAppConfig_customHeaders(rName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppConfig_name(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "custom_headers", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccApp_CustomRules(t *testing.T) {
 	ctx := acctest.Context(t)
 	var app amplify.App
@@ -798,6 +838,26 @@ resource "aws_amplify_app" "test" {
 `, rName, buildSpec)
 }
 
+func testAccAppConfig_customHeaders(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+
+  custom_headers = <<-EOT
+    customHeaders:
+      - pattern: '**'
+        headers:
+          - key: 'Strict-Transport-Security'
+            value: 'max-age=31536000; includeSubDomains'
+      - pattern: '*.html'
+        headers:
+          - key: 'X-Frame-Options'
+            value: 'SAMEORIGIN'
+  EOT
+}
+`, rName)
+}
+
 func testAccAppConfig_customRules(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_amplify_app" "test" {
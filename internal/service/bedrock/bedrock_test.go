@@ -16,6 +16,7 @@ func TestAccBedrock_serial(t *testing.T) {
 		"ModelInvocationLoggingConfiguration": {
 			"basic":      testAccModelInvocationLoggingConfiguration_basic,
 			"disappears": testAccModelInvocationLoggingConfiguration_disappears,
+			"s3":         testAccModelInvocationLoggingConfiguration_s3,
 		},
 	}
 
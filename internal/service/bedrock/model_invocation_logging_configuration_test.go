@@ -87,6 +87,45 @@ func testAccModelInvocationLoggingConfiguration_disappears(t *testing.T) {
 	})
 }
 
+func testAccModelInvocationLoggingConfiguration_s3(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_model_invocation_logging_configuration.test"
+	s3BucketResourceName := "aws_s3_bucket.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelInvocationLoggingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelInvocationLoggingConfigurationConfig_s3(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckModelInvocationLoggingConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.embedding_data_delivery_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.image_data_delivery_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.text_data_delivery_enabled", "true"),
+					resource.TestCheckNoResourceAttr(resourceName, "logging_config.cloudwatch_config.log_group_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "logging_config.s3_config.bucket_name", s3BucketResourceName, "id"),
+				),
+			},
+			{
+				Config:   testAccModelInvocationLoggingConfigurationConfig_s3(rName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckModelInvocationLoggingConfigurationExists(ctx context.Context, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -136,7 +175,7 @@ func testAccCheckModelInvocationLoggingConfigurationDestroy(ctx context.Context)
 	}
 }
 
-func testAccModelInvocationLoggingConfigurationConfig_basic(rName string) string {
+func testAccModelInvocationLoggingConfigurationConfig_baseS3(rName string) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
 data "aws_region" "current" {}
@@ -181,7 +220,11 @@ resource "aws_s3_bucket_policy" "test" {
 }
 EOF
 }
+`, rName)
+}
 
+func testAccModelInvocationLoggingConfigurationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccModelInvocationLoggingConfigurationConfig_baseS3(rName), fmt.Sprintf(`
 resource "aws_cloudwatch_log_group" "test" {
   name = %[1]q
 }
@@ -258,5 +301,22 @@ resource "aws_bedrock_model_invocation_logging_configuration" "test" {
     }
   }
 }
-`, rName)
+`, rName))
+}
+
+func testAccModelInvocationLoggingConfigurationConfig_s3(rName string) string {
+	return acctest.ConfigCompose(testAccModelInvocationLoggingConfigurationConfig_baseS3(rName), `
+resource "aws_bedrock_model_invocation_logging_configuration" "test" {
+  depends_on = [aws_s3_bucket_policy.test]
+
+  logging_config {
+    embedding_data_delivery_enabled = false
+    image_data_delivery_enabled     = false
+    text_data_delivery_enabled      = true
+    s3_config {
+      bucket_name = aws_s3_bucket.test.id
+    }
+  }
+}
+`)
 }
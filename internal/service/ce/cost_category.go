@@ -460,12 +460,13 @@ func resourceCostCategoryUpdate(ctx context.Context, d *schema.ResourceData, met
 			RuleVersion:     aws.String(d.Get("rule_version").(string)),
 		}
 
-		if d.HasChange("default_value") {
-			input.DefaultValue = aws.String(d.Get("default_value").(string))
+		// UpdateCostCategoryDefinition replaces the whole definition, so unchanged values must be sent again.
+		if v, ok := d.GetOk("default_value"); ok {
+			input.DefaultValue = aws.String(v.(string))
 		}
 
-		if d.HasChange("split_charge_rule") {
-			input.SplitChargeRules = expandCostCategorySplitChargeRules(d.Get("split_charge_rule").(*schema.Set).List())
+		if v, ok := d.GetOk("split_charge_rule"); ok && v.(*schema.Set).Len() > 0 {
+			input.SplitChargeRules = expandCostCategorySplitChargeRules(v.(*schema.Set).List())
 		}
 
 		_, err := conn.UpdateCostCategoryDefinitionWithContext(ctx, input)
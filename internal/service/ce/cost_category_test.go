@@ -180,6 +180,65 @@ func TestAccCECostCategory_splitCharge(t *testing.T) {
 	})
 }
 
+func TestAccCECostCategory_defaultValue(t *testing.T) {
+	ctx := acctest.Context(t)
+	var output costexplorer.CostCategory
+	resourceName := "aws_ce_cost_category.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCostCategoryDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostCategoryConfig_defaultValue(rName, "Unallocated", "-prod"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCostCategoryExists(ctx, resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "default_value", "Unallocated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCostCategoryConfig_defaultValue(rName, "Unallocated", "-production"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCostCategoryExists(ctx, resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "default_value", "Unallocated"),
+				),
+			},
+			{
+				Config: testAccCostCategoryConfig_defaultValue(rName, "Other", "-production"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCostCategoryExists(ctx, resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "default_value", "Other"),
+				),
+			},
+			{
+				Config: testAccCostCategoryConfig_defaultValueSplitCharge(rName, "Other", "-prod"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCostCategoryExists(ctx, resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "default_value", "Other"),
+					resource.TestCheckResourceAttr(resourceName, "split_charge_rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccCostCategoryConfig_defaultValueSplitCharge(rName, "Other", "-production"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCostCategoryExists(ctx, resourceName, &output),
+					resource.TestCheckResourceAttr(resourceName, "default_value", "Other"),
+					resource.TestCheckResourceAttr(resourceName, "split_charge_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "split_charge_rule.0.method", "EVEN"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCECostCategory_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var output costexplorer.CostCategory
@@ -467,6 +526,94 @@ resource "aws_ce_cost_category" "test" {
 `, rName, method)
 }
 
+func testAccCostCategoryConfig_defaultValue(rName, defaultValue, suffix string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test" {
+  name          = %[1]q
+  rule_version  = "CostCategoryExpression.v1"
+  default_value = %[2]q
+
+  rule {
+    value = "production"
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = [%[3]q]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+    type = "REGULAR"
+  }
+}
+`, rName, defaultValue, suffix)
+}
+
+func testAccCostCategoryConfig_defaultValueSplitCharge(rName, defaultValue, suffix string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_category" "test1" {
+  name         = "%[1]s-1"
+  rule_version = "CostCategoryExpression.v1"
+
+  rule {
+    value = "production"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-prod"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+
+    type = "REGULAR"
+  }
+}
+
+resource "aws_ce_cost_category" "test2" {
+  name         = "%[1]s-2"
+  rule_version = "CostCategoryExpression.v1"
+
+  rule {
+    value = "staging"
+
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = ["-stg"]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+
+    type = "REGULAR"
+  }
+}
+
+resource "aws_ce_cost_category" "test" {
+  name          = %[1]q
+  rule_version  = "CostCategoryExpression.v1"
+  default_value = %[2]q
+
+  rule {
+    value = "production"
+    rule {
+      dimension {
+        key           = "LINKED_ACCOUNT_NAME"
+        values        = [%[3]q]
+        match_options = ["ENDS_WITH"]
+      }
+    }
+    type = "REGULAR"
+  }
+
+  split_charge_rule {
+    method  = "EVEN"
+    source  = aws_ce_cost_category.test1.id
+    targets = [aws_ce_cost_category.test2.id]
+  }
+}
+`, rName, defaultValue, suffix)
+}
+
 func testAccCostCategoryConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_ce_cost_category" "test" {
@@ -30,9 +30,10 @@ func TestAccChime_serial(t *testing.T) {
 			"update":     testAccVoiceConnectorLogging_update,
 		},
 		"VoiceConnectorOrigination": {
-			"basic":      testAccVoiceConnectorOrigination_basic,
-			"disappears": testAccVoiceConnectorOrigination_disappears,
-			"update":     testAccVoiceConnectorOrigination_update,
+			"basic":                testAccVoiceConnectorOrigination_basic,
+			"disappears":           testAccVoiceConnectorOrigination_disappears,
+			"update":               testAccVoiceConnectorOrigination_update,
+			"migrateFromPluginSDK": testAccVoiceConnectorOrigination_migrateFromPluginSDK,
 		},
 		"VoiceConnectorStreaming": {
 			"basic":      testAccVoiceConnectorStreaming_basic,
@@ -5,6 +5,8 @@ package chime
 
 // Exports for use in tests only.
 var (
+	ResourceVoiceConnectorOrigination = newVoiceConnectorOriginationResource
+
 	FindVoiceConnectorByID                       = findVoiceConnectorByID
 	FindVoiceConnectorGroupByID                  = findVoiceConnectorGroupByID
 	FindVoiceConnectorLoggingByID                = findVoiceConnectorLoggingByID
@@ -5,226 +5,273 @@ package chime
 
 import (
 	"context"
-	"log"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/chimesdkvoice"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/chimesdkvoice/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// @SDKResource("aws_chime_voice_connector_origination")
-func ResourceVoiceConnectorOrigination() *schema.Resource {
-	return &schema.Resource{
-		CreateWithoutTimeout: resourceVoiceConnectorOriginationCreate,
-		ReadWithoutTimeout:   resourceVoiceConnectorOriginationRead,
-		UpdateWithoutTimeout: resourceVoiceConnectorOriginationUpdate,
-		DeleteWithoutTimeout: resourceVoiceConnectorOriginationDelete,
+// @FrameworkResource(name="Voice Connector Origination")
+func newVoiceConnectorOriginationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &voiceConnectorOriginationResource{}
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+	return r, nil
+}
+
+type voiceConnectorOriginationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
 
-		Schema: map[string]*schema.Schema{
-			"disabled": {
-				Type:     schema.TypeBool,
+func (r *voiceConnectorOriginationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_chime_voice_connector_origination"
+}
+
+func (r *voiceConnectorOriginationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"disabled": schema.BoolAttribute{
 				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
-			"route": {
-				Type:     schema.TypeSet,
+			names.AttrID: framework.IDAttribute(),
+			"voice_connector_id": schema.StringAttribute{
 				Required: true,
-				MinItems: 1,
-				MaxItems: 20,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"host": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.IsIPAddress,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"route": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[originationRouteModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeBetween(1, 20),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"host": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.Any(
+									fwvalidators.IPv4Address(),
+									fwvalidators.IPv6Address(),
+								),
+							},
 						},
-						"port": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      5060,
-							ValidateFunc: validation.IsPortNumber,
+						"port": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							Default:  int64default.StaticInt64(5060),
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
 						},
-						"priority": {
-							Type:         schema.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntBetween(1, 99),
+						"priority": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 99),
+							},
 						},
-						"protocol": {
-							Type:             schema.TypeString,
-							Required:         true,
-							ValidateDiagFunc: enum.Validate[awstypes.OriginationRouteProtocol](),
+						"protocol": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.OriginationRouteProtocol](),
+							Required:   true,
 						},
-						"weight": {
-							Type:         schema.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntBetween(1, 99),
+						"weight": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 99),
+							},
 						},
 					},
 				},
 			},
-			"voice_connector_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
 		},
 	}
 }
 
-func resourceVoiceConnectorOriginationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+func (r *voiceConnectorOriginationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data voiceConnectorOriginationResourceModel
 
-	conn := meta.(*conns.AWSClient).ChimeSDKVoiceClient(ctx)
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
 
-	vcId := d.Get("voice_connector_id").(string)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ChimeSDKVoiceClient(ctx)
 
+	vcID := data.VoiceConnectorID.ValueString()
 	input := &chimesdkvoice.PutVoiceConnectorOriginationInput{
-		VoiceConnectorId: aws.String(vcId),
-		Origination: &awstypes.Origination{
-			Routes: expandOriginationRoutes(d.Get("route").(*schema.Set).List()),
-		},
+		Origination:      &awstypes.Origination{},
+		VoiceConnectorId: aws.String(vcID),
 	}
-
-	if v, ok := d.GetOk("disabled"); ok {
-		input.Origination.Disabled = aws.Bool(v.(bool))
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input.Origination)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	if _, err := conn.PutVoiceConnectorOrigination(ctx, input); err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating Chime Voice Connector (%s) origination: %s", vcId, err)
+	_, err := conn.PutVoiceConnectorOrigination(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Chime Voice Connector (%s) origination", vcID), err.Error())
+
+		return
 	}
 
-	d.SetId(vcId)
+	// Set values for unknowns.
+	data.ID = types.StringValue(vcID)
 
-	return append(diags, resourceVoiceConnectorOriginationRead(ctx, d, meta)...)
-}
+	if _, err := FindVoiceConnectorResourceWithRetry(ctx, true, func() (*awstypes.Origination, error) {
+		return findVoiceConnectorOriginationByID(ctx, conn, vcID)
+	}); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Chime Voice Connector (%s) origination create", vcID), err.Error())
 
-func resourceVoiceConnectorOriginationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+		return
+	}
 
-	conn := meta.(*conns.AWSClient).ChimeSDKVoiceClient(ctx)
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
 
-	resp, err := FindVoiceConnectorResourceWithRetry(ctx, d.IsNewResource(), func() (*awstypes.Origination, error) {
-		return findVoiceConnectorOriginationByID(ctx, conn, d.Id())
-	})
+func (r *voiceConnectorOriginationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data voiceConnectorOriginationResourceModel
 
-	if tfresource.TimedOut(err) {
-		resp, err = findVoiceConnectorOriginationByID(ctx, conn, d.Id())
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	if !d.IsNewResource() && tfresource.NotFound(err) {
-		log.Printf("[WARN] Chime Voice Connector (%s) origination not found, removing from state", d.Id())
-		d.SetId("")
-		return diags
+	conn := r.Meta().ChimeSDKVoiceClient(ctx)
+
+	output, err := findVoiceConnectorOriginationByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "getting Chime Voice Connector (%s) origination: %s", d.Id(), err)
-	}
+		response.Diagnostics.AddError(fmt.Sprintf("reading Chime Voice Connector (%s) origination", data.ID.ValueString()), err.Error())
 
-	d.Set("disabled", resp.Disabled)
-	d.Set("voice_connector_id", d.Id())
+		return
+	}
 
-	if err := d.Set("route", flattenOriginationRoutes(resp.Routes)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting Chime Voice Connector (%s) origination routes: %s", d.Id(), err)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	return diags
+	data.Disabled = types.BoolValue(aws.ToBool(output.Disabled))
+	// Set attributes for import.
+	data.VoiceConnectorID = data.ID
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
-func resourceVoiceConnectorOriginationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+func (r *voiceConnectorOriginationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new voiceConnectorOriginationResourceModel
 
-	conn := meta.(*conns.AWSClient).ChimeSDKVoiceClient(ctx)
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
 
-	if d.HasChanges("route", "disabled") {
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ChimeSDKVoiceClient(ctx)
+
+	if !new.Disabled.Equal(old.Disabled) || !new.Routes.Equal(old.Routes) {
 		input := &chimesdkvoice.PutVoiceConnectorOriginationInput{
-			VoiceConnectorId: aws.String(d.Id()),
-			Origination: &awstypes.Origination{
-				Routes: expandOriginationRoutes(d.Get("route").(*schema.Set).List()),
-			},
+			Origination:      &awstypes.Origination{},
+			VoiceConnectorId: aws.String(new.ID.ValueString()),
 		}
-
-		if v, ok := d.GetOk("disabled"); ok {
-			input.Origination.Disabled = aws.Bool(v.(bool))
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input.Origination)...)
+		if response.Diagnostics.HasError() {
+			return
 		}
 
 		_, err := conn.PutVoiceConnectorOrigination(ctx, input)
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating Chime Voice Connector (%s) origination: %s", d.Id(), err)
+			response.Diagnostics.AddError(fmt.Sprintf("updating Chime Voice Connector (%s) origination", new.ID.ValueString()), err.Error())
+
+			return
 		}
 	}
 
-	return append(diags, resourceVoiceConnectorOriginationRead(ctx, d, meta)...)
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
 }
 
-func resourceVoiceConnectorOriginationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+func (r *voiceConnectorOriginationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data voiceConnectorOriginationResourceModel
 
-	conn := meta.(*conns.AWSClient).ChimeSDKVoiceClient(ctx)
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
 
-	input := &chimesdkvoice.DeleteVoiceConnectorOriginationInput{
-		VoiceConnectorId: aws.String(d.Id()),
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	_, err := conn.DeleteVoiceConnectorOrigination(ctx, input)
+	conn := r.Meta().ChimeSDKVoiceClient(ctx)
+
+	_, err := conn.DeleteVoiceConnectorOrigination(ctx, &chimesdkvoice.DeleteVoiceConnectorOriginationInput{
+		VoiceConnectorId: aws.String(data.ID.ValueString()),
+	})
 
 	if errs.IsA[*awstypes.NotFoundException](err) {
-		return diags
+		return
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting Chime Voice Connector (%s) origination: %s", d.Id(), err)
-	}
-
-	return diags
-}
-
-func expandOriginationRoutes(data []interface{}) []awstypes.OriginationRoute {
-	var originationRoutes []awstypes.OriginationRoute
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Chime Voice Connector (%s) origination", data.ID.ValueString()), err.Error())
 
-	for _, rItem := range data {
-		item := rItem.(map[string]interface{})
-		originationRoutes = append(originationRoutes, awstypes.OriginationRoute{
-			Host:     aws.String(item["host"].(string)),
-			Port:     aws.Int32(int32(item["port"].(int))),
-			Priority: aws.Int32(int32(item["priority"].(int))),
-			Protocol: awstypes.OriginationRouteProtocol(item["protocol"].(string)),
-			Weight:   aws.Int32(int32(item["weight"].(int))),
-		})
+		return
 	}
-
-	return originationRoutes
 }
 
-func flattenOriginationRoutes(routes []awstypes.OriginationRoute) []interface{} {
-	var rawRoutes []interface{}
-
-	for _, route := range routes {
-		r := map[string]interface{}{
-			"host":     aws.ToString(route.Host),
-			"port":     aws.ToInt32(route.Port),
-			"priority": aws.ToInt32(route.Priority),
-			"protocol": string(route.Protocol),
-			"weight":   aws.ToInt32(route.Weight),
-		}
-
-		rawRoutes = append(rawRoutes, r)
-	}
+type voiceConnectorOriginationResourceModel struct {
+	Disabled         types.Bool                                            `tfsdk:"disabled"`
+	ID               types.String                                          `tfsdk:"id"`
+	Routes           fwtypes.SetNestedObjectValueOf[originationRouteModel] `tfsdk:"route"`
+	VoiceConnectorID types.String                                          `tfsdk:"voice_connector_id"`
+}
 
-	return rawRoutes
+type originationRouteModel struct {
+	Host     types.String                                          `tfsdk:"host"`
+	Port     types.Int64                                           `tfsdk:"port"`
+	Priority types.Int64                                           `tfsdk:"priority"`
+	Protocol fwtypes.StringEnum[awstypes.OriginationRouteProtocol] `tfsdk:"protocol"`
+	Weight   types.Int64                                           `tfsdk:"weight"`
 }
 
 func findVoiceConnectorOriginationByID(ctx context.Context, conn *chimesdkvoice.Client, id string) (*awstypes.Origination, error) {
@@ -241,13 +288,13 @@ func findVoiceConnectorOriginationByID(ctx context.Context, conn *chimesdkvoice.
 		}
 	}
 
-	if resp == nil || resp.Origination == nil {
-		return nil, tfresource.NewEmptyResultError(in)
-	}
-
 	if err != nil {
 		return nil, err
 	}
 
+	if resp == nil || resp.Origination == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
 	return resp.Origination, nil
 }
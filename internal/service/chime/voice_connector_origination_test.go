@@ -71,7 +71,7 @@ func testAccVoiceConnectorOrigination_disappears(t *testing.T) {
 				Config: testAccVoiceConnectorOriginationConfig_basic(name),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVoiceConnectorOriginationExists(ctx, resourceName),
-					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfchime.ResourceVoiceConnectorOrigination(), resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfchime.ResourceVoiceConnectorOrigination, resourceName),
 				),
 				ExpectNonEmptyPlan: true,
 			},
@@ -79,6 +79,41 @@ func testAccVoiceConnectorOrigination_disappears(t *testing.T) {
 	})
 }
 
+func testAccVoiceConnectorOrigination_migrateFromPluginSDK(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chime_voice_connector_origination.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, names.ChimeSDKVoiceEndpointID),
+		CheckDestroy: testAccCheckVoiceConnectorOriginationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"aws": {
+						Source:            "hashicorp/aws",
+						VersionConstraint: "5.33.0",
+					},
+				},
+				Config: testAccVoiceConnectorOriginationConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVoiceConnectorOriginationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "1"),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				Config:                   testAccVoiceConnectorOriginationConfig_basic(name),
+				PlanOnly:                 true,
+			},
+		},
+	})
+}
+
 func testAccVoiceConnectorOrigination_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -352,6 +352,11 @@ func resourceStackSetUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 	if v, ok := d.GetOk("managed_execution"); ok {
 		input.ManagedExecution = expandManagedExecution(v.([]interface{}))
+	} else if d.HasChange("managed_execution") {
+		// Removing the configuration block turns off managed execution.
+		input.ManagedExecution = &cloudformation.ManagedExecution{
+			Active: aws.Bool(false),
+		}
 	}
 
 	if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -460,7 +465,7 @@ func expandAutoDeployment(l []interface{}) *cloudformation.AutoDeployment {
 }
 
 func expandManagedExecution(l []interface{}) *cloudformation.ManagedExecution {
-	if len(l) == 0 {
+	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
 
@@ -378,6 +378,51 @@ func TestAccCloudFormationStackSetInstance_operationPreferences(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStackSetInstance_managedExecution(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stackInstanceSummaries []*cloudformation.StackInstanceSummary
+	var stackSet cloudformation.StackSet
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+	stackSetResourceName := "aws_cloudformation_stack_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckStackSet(ctx, t)
+			acctest.PreCheckOrganizationsEnabled(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			acctest.PreCheckIAMServiceLinkedRole(ctx, t, "/aws-service-role/stacksets.cloudformation.amazonaws.com")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, cloudformation.EndpointsID, "organizations"),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackSetInstanceForOrganizationalUnitDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackSetInstanceConfig_managedExecution(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackSetExists(ctx, stackSetResourceName, &stackSet),
+					testAccCheckStackSetManagedExecutionActive(&stackSet),
+					testAccCheckStackSetInstanceForOrganizationalUnitExists(ctx, resourceName, stackInstanceSummaries),
+					testAccCheckStackSetInstanceForOrganizationalUnitCurrentInMemberAccounts(ctx, resourceName),
+					resource.TestCheckResourceAttr(stackSetResourceName, "managed_execution.#", "1"),
+					resource.TestCheckResourceAttr(stackSetResourceName, "managed_execution.0.active", "true"),
+				),
+			},
+			{
+				// Updating the StackSet runs a managed execution operation against the deployed member account stacks.
+				Config: testAccStackSetInstanceConfig_managedExecution(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackSetExists(ctx, stackSetResourceName, &stackSet),
+					testAccCheckStackSetManagedExecutionActive(&stackSet),
+					testAccCheckStackSetInstanceForOrganizationalUnitCurrentInMemberAccounts(ctx, resourceName),
+					resource.TestCheckResourceAttr(stackSetResourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckStackSetInstanceExists(ctx context.Context, resourceName string, v *cloudformation.StackInstance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -439,6 +484,63 @@ func testAccCheckStackSetInstanceForOrganizationalUnitExists(ctx context.Context
 	}
 }
 
+// testAccCheckStackSetInstanceForOrganizationalUnitCurrentInMemberAccounts checks that
+// the organizational unit's stack instances are deployed to member accounts and are up to date
+// with the StackSet.
+func testAccCheckStackSetInstanceForOrganizationalUnitCurrentInMemberAccounts(ctx context.Context, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		callAs := rs.Primary.Attributes["call_as"]
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn(ctx)
+
+		stackSetName, accountOrOrgID, region, err := tfcloudformation.StackSetInstanceParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		orgIDs := strings.Split(accountOrOrgID, "/")
+
+		output, err := tfcloudformation.FindStackInstanceSummariesByOrgIDs(ctx, conn, stackSetName, region, callAs, orgIDs)
+
+		if err != nil {
+			return err
+		}
+
+		accountID := acctest.Provider.Meta().(*conns.AWSClient).AccountID
+		var memberAccounts int
+
+		for _, v := range output {
+			if status := aws.StringValue(v.Status); status != cloudformation.StackInstanceStatusCurrent {
+				return fmt.Errorf("CloudFormation StackSet (%s) Instance in account (%s) status is %s", stackSetName, aws.StringValue(v.Account), status)
+			}
+
+			if aws.StringValue(v.Account) != accountID {
+				memberAccounts++
+			}
+		}
+
+		if memberAccounts == 0 {
+			return fmt.Errorf("CloudFormation StackSet (%s) has no Instances in member accounts", stackSetName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckStackSetManagedExecutionActive(stackSet *cloudformation.StackSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if stackSet.ManagedExecution == nil || !aws.BoolValue(stackSet.ManagedExecution.Active) {
+			return fmt.Errorf("CloudFormation StackSet (%s) managed execution is not active", aws.StringValue(stackSet.StackSetName))
+		}
+
+		return nil
+	}
+}
+
 // testAccCheckStackSetInstanceForOrganizationalUnitDestroy is a variant of the
 // standard CheckDestroyFunc which expects the resource ID to contain organizational
 // unit IDs rather than an account ID
@@ -719,7 +821,7 @@ resource "aws_cloudformation_stack_set_instance" "test" {
 `, retainStack))
 }
 
-func testAccStackSetInstanceBaseConfig_ServiceManagedStackSet(rName string) string {
+func testAccStackSetInstanceBaseConfig_ServiceManaged(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
 
@@ -812,7 +914,11 @@ EOF
 }
 
 data "aws_organizations_organization" "test" {}
+`, rName)
+}
 
+func testAccStackSetInstanceBaseConfig_ServiceManagedStackSet(rName string) string {
+	return acctest.ConfigCompose(testAccStackSetInstanceBaseConfig_ServiceManaged(rName), fmt.Sprintf(`
 resource "aws_cloudformation_stack_set" "test" {
   depends_on = [data.aws_organizations_organization.test]
 
@@ -832,7 +938,7 @@ TEMPLATE
     ignore_changes = [administration_role_arn]
   }
 }
-`, rName, testAccStackSetTemplateBodyVPC(rName))
+`, rName, testAccStackSetTemplateBodyVPC(rName)))
 }
 
 func testAccStackSetInstanceConfig_deploymentTargets(rName string) string {
@@ -886,3 +992,42 @@ resource "aws_cloudformation_stack_set_instance" "test" {
 }
 `)
 }
+
+func testAccStackSetInstanceConfig_managedExecution(rName, description string) string {
+	return acctest.ConfigCompose(testAccStackSetInstanceBaseConfig_ServiceManaged(rName), fmt.Sprintf(`
+resource "aws_cloudformation_stack_set" "test" {
+  depends_on = [data.aws_organizations_organization.test]
+
+  description      = %[3]q
+  name             = %[1]q
+  permission_model = "SERVICE_MANAGED"
+
+  auto_deployment {
+    enabled                          = true
+    retain_stacks_on_account_removal = false
+  }
+
+  managed_execution {
+    active = true
+  }
+
+  template_body = <<TEMPLATE
+%[2]s
+TEMPLATE
+
+  lifecycle {
+    ignore_changes = [administration_role_arn]
+  }
+}
+
+resource "aws_cloudformation_stack_set_instance" "test" {
+  depends_on = [aws_iam_role_policy.Administration, aws_iam_role_policy.Execution]
+
+  deployment_targets {
+    organizational_unit_ids = [data.aws_organizations_organization.test.roots[0].id]
+  }
+
+  stack_set_name = aws_cloudformation_stack_set.test.name
+}
+`, rName, testAccStackSetTemplateBodyVPC(rName), description))
+}
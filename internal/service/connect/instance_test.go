@@ -67,7 +67,7 @@ func testAccInstance_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "early_media_enabled", "false"),
 					resource.TestCheckResourceAttr(resourceName, "inbound_calls_enabled", "false"),
 					resource.TestMatchResourceAttr(resourceName, "instance_alias", regexache.MustCompile(rName)),
-					resource.TestCheckResourceAttr(resourceName, "multi_party_conference_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "multi_party_conference_enabled", "true"),
 					resource.TestCheckResourceAttr(resourceName, "outbound_calls_enabled", "false"),
 					resource.TestCheckResourceAttr(resourceName, "status", connect.InstanceStatusActive),
 				),
@@ -208,7 +208,7 @@ resource "aws_connect_instance" "test" {
   identity_management_type         = "CONNECT_MANAGED"
   inbound_calls_enabled            = false
   instance_alias                   = %[1]q
-  multi_party_conference_enabled   = false
+  multi_party_conference_enabled   = true
   outbound_calls_enabled           = false
 }
 `, rName)
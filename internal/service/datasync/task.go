@@ -556,10 +556,13 @@ func flattenTaskReportConfig(options *datasync.TaskReportConfig) []interface{} {
 		"s3_object_versioning": aws.StringValue(options.ObjectVersionIds),
 		"output_type":          aws.StringValue(options.OutputType),
 		"report_level":         aws.StringValue(options.ReportLevel),
-		"s3_destination":       flattenTaskReportConfigS3Destination(options.Destination.S3),
 		"report_overrides":     flattenTaskReportConfigReportOverrides(options.Overrides),
 	}
 
+	if options.Destination != nil {
+		m["s3_destination"] = flattenTaskReportConfigS3Destination(options.Destination.S3)
+	}
+
 	return []interface{}{m}
 }
 
@@ -568,11 +571,22 @@ func flattenTaskReportConfigReportOverrides(options *datasync.ReportOverrides) [
 		return []interface{}{}
 	}
 
-	m := map[string]interface{}{
-		"deleted_override":     aws.StringValue(options.Deleted.ReportLevel),
-		"skipped_override":     aws.StringValue(options.Skipped.ReportLevel),
-		"transferred_override": aws.StringValue(options.Transferred.ReportLevel),
-		"verified_override":    aws.StringValue(options.Verified.ReportLevel),
+	m := map[string]interface{}{}
+
+	if options.Deleted != nil {
+		m["deleted_override"] = aws.StringValue(options.Deleted.ReportLevel)
+	}
+
+	if options.Skipped != nil {
+		m["skipped_override"] = aws.StringValue(options.Skipped.ReportLevel)
+	}
+
+	if options.Transferred != nil {
+		m["transferred_override"] = aws.StringValue(options.Transferred.ReportLevel)
+	}
+
+	if options.Verified != nil {
+		m["verified_override"] = aws.StringValue(options.Verified.ReportLevel)
 	}
 
 	return []interface{}{m}
@@ -662,9 +676,19 @@ func expandTaskReportConfig(l []interface{}) *datasync.TaskReportConfig {
 
 	dest := m["s3_destination"].([]interface{})
 	reportConfig = reportConfig.SetDestination(expandTaskReportDestination(dest))
-	reportConfig = reportConfig.SetObjectVersionIds(m["s3_object_versioning"].(string))
-	reportConfig = reportConfig.SetOutputType(m["output_type"].(string))
-	reportConfig = reportConfig.SetReportLevel(m["report_level"].(string))
+
+	if v, ok := m["s3_object_versioning"].(string); ok && v != "" {
+		reportConfig = reportConfig.SetObjectVersionIds(v)
+	}
+
+	if v, ok := m["output_type"].(string); ok && v != "" {
+		reportConfig = reportConfig.SetOutputType(v)
+	}
+
+	if v, ok := m["report_level"].(string); ok && v != "" {
+		reportConfig = reportConfig.SetReportLevel(v)
+	}
+
 	o := m["report_overrides"].([]interface{})
 	reportConfig = reportConfig.SetOverrides(expandTaskReportOverrides(o))
 
@@ -690,20 +714,33 @@ func expandTaskReportOverrides(l []interface{}) *datasync.ReportOverrides {
 		return nil
 	}
 	m := l[0].(map[string]interface{})
-	return &datasync.ReportOverrides{
-		Deleted: &datasync.ReportOverride{
-			ReportLevel: aws.String(m["deleted_override"].(string)),
-		},
-		Skipped: &datasync.ReportOverride{
-			ReportLevel: aws.String(m["skipped_override"].(string)),
-		},
-		Transferred: &datasync.ReportOverride{
-			ReportLevel: aws.String(m["transferred_override"].(string)),
-		},
-		Verified: &datasync.ReportOverride{
-			ReportLevel: aws.String(m["verified_override"].(string)),
-		},
+	overrides := &datasync.ReportOverrides{}
+
+	if v, ok := m["deleted_override"].(string); ok && v != "" {
+		overrides.Deleted = &datasync.ReportOverride{
+			ReportLevel: aws.String(v),
+		}
+	}
+
+	if v, ok := m["skipped_override"].(string); ok && v != "" {
+		overrides.Skipped = &datasync.ReportOverride{
+			ReportLevel: aws.String(v),
+		}
+	}
+
+	if v, ok := m["transferred_override"].(string); ok && v != "" {
+		overrides.Transferred = &datasync.ReportOverride{
+			ReportLevel: aws.String(v),
+		}
 	}
+
+	if v, ok := m["verified_override"].(string); ok && v != "" {
+		overrides.Verified = &datasync.ReportOverride{
+			ReportLevel: aws.String(v),
+		}
+	}
+
+	return overrides
 }
 
 func expandFilterRules(l []interface{}) []*datasync.FilterRule {
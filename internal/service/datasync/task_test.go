@@ -807,7 +807,7 @@ func TestAccDataSyncTask_DefaultSyncOptions_verifyMode(t *testing.T) {
 
 func TestAccDataSyncTask_taskReportConfig(t *testing.T) {
 	ctx := acctest.Context(t)
-	var task1 datasync.DescribeTaskOutput
+	var task1, task2 datasync.DescribeTaskOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_datasync_task.test"
 
@@ -841,6 +841,20 @@ func TestAccDataSyncTask_taskReportConfig(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccTaskConfig_taskReportConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskExists(ctx, resourceName, &task2),
+					testAccCheckTaskNotRecreated(&task1, &task2),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.0.output_type", "SUMMARY_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.0.report_level", "ERRORS_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.0.s3_destination.0.subdirectory", "updated/"),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.0.report_overrides.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.0.report_overrides.0.deleted_override", ""),
+					resource.TestCheckResourceAttr(resourceName, "task_report_config.0.report_overrides.0.transferred_override", "SUCCESSES_AND_ERRORS"),
+				),
+			},
 		},
 	})
 }
@@ -1511,7 +1525,7 @@ resource "aws_datasync_task" "test" {
 `, rName, key1, value1, key2, value2))
 }
 
-func testAccTaskConfig_taskReportConfig(rName string) string {
+func testAccTaskConfig_baseTaskReportConfig(rName string) string {
 	return acctest.ConfigCompose(
 		testAccTaskConfig_baseLocationS3(rName),
 		testAccTaskConfig_baseLocationNFS(rName),
@@ -1557,7 +1571,13 @@ resource "aws_iam_role_policy" "report_test" {
 }
 POLICY
 }
+`, rName))
+}
 
+func testAccTaskConfig_taskReportConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccTaskConfig_baseTaskReportConfig(rName),
+		fmt.Sprintf(`
 resource "aws_datasync_task" "test" {
   destination_location_arn = aws_datasync_location_s3.test.arn
   name                     = %[1]q
@@ -1582,3 +1602,28 @@ resource "aws_datasync_task" "test" {
 }
 `, rName))
 }
+
+func testAccTaskConfig_taskReportConfigUpdated(rName string) string {
+	return acctest.ConfigCompose(
+		testAccTaskConfig_baseTaskReportConfig(rName),
+		fmt.Sprintf(`
+resource "aws_datasync_task" "test" {
+  destination_location_arn = aws_datasync_location_s3.test.arn
+  name                     = %[1]q
+  source_location_arn      = aws_datasync_location_nfs.test.arn
+
+  task_report_config {
+    s3_destination {
+      bucket_access_role_arn = aws_iam_role.report_test.arn
+      s3_bucket_arn          = aws_s3_bucket.report_test.arn
+      subdirectory           = "updated/"
+    }
+    report_overrides {
+      transferred_override = "SUCCESSES_AND_ERRORS"
+    }
+    output_type  = "SUMMARY_ONLY"
+    report_level = "ERRORS_ONLY"
+  }
+}
+`, rName))
+}
@@ -481,21 +481,22 @@ func ResourceFleet() *schema.Resource {
 							Default:      FleetOnDemandAllocationStrategyLowestPrice,
 							ValidateFunc: validation.StringInSlice(FleetOnDemandAllocationStrategy_Values(), false),
 						},
-						// Pending AWS to provide this attribute back in the `Describe` call
-						// "capacity_reservation_options": {
-						// 	Type:     schema.TypeList,
-						// 	Optional: true,
-						// 	MaxItems: 1,
-						// 	Elem: &schema.Resource{
-						// 		Schema: map[string]*schema.Schema{
-						// 			"usage_strategy": {
-						// 				Type:         schema.TypeString,
-						// 				Optional:     true,
-						// 				ValidateFunc: validation.StringInSlice(ec2.FleetCapacityReservationUsageStrategy_Values(), false),
-						// 			},
-						// 		},
-						// 	},
-						// },
+						"capacity_reservation_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"usage_strategy": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(ec2.FleetCapacityReservationUsageStrategy_Values(), false),
+									},
+								},
+							},
+						},
 						"max_total_price": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -943,6 +944,15 @@ func resourceFleetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v
 				}
 			}
 		}
+
+		if v, ok := diff.GetOk("on_demand_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+			if v, ok := tfMap["capacity_reservation_options"].([]interface{}); ok && len(v) > 0 {
+				if fleetType := diff.Get("type").(string); fleetType != ec2.FleetTypeInstant {
+					return fmt.Errorf(`EC2 Fleet has an invalid configuration and can not be created. Capacity Reservation options can only be specified for fleets of type instant, got %q.`, fleetType)
+				}
+			}
+		}
 	}
 
 	return nil
@@ -15,7 +15,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -188,7 +190,28 @@ func ResourceVPCEndpoint() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				if !diff.NewValueKnown("private_dns_enabled") || diff.Get("private_dns_enabled").(bool) {
+					return nil
+				}
+
+				// dns_options is Optional+Computed, so only check the value actually configured.
+				v := diff.GetRawConfig().GetAttr("dns_options")
+				if !v.IsKnown() || v.IsNull() || v.LengthInt() == 0 {
+					return nil
+				}
+
+				if v := v.Index(cty.NumberIntVal(0)); v.IsKnown() && !v.IsNull() {
+					if v := v.GetAttr("private_dns_only_for_inbound_resolver_endpoint"); v.IsKnown() && !v.IsNull() && v.True() {
+						return fmt.Errorf("private_dns_only_for_inbound_resolver_endpoint can only be set when private_dns_enabled is true")
+					}
+				}
+
+				return nil
+			},
+		),
 	}
 }
 
@@ -402,6 +425,13 @@ func resourceVPCEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 		if d.HasChange("private_dns_enabled") {
 			input.PrivateDnsEnabled = aws.Bool(privateDNSEnabled)
+
+			// Private DNS cannot be disabled while it is restricted to inbound Resolver endpoints.
+			if !privateDNSEnabled && input.DnsOptions == nil && isAmazonS3VPCEndpoint(d.Get("service_name").(string)) && d.Get("dns_options.0.private_dns_only_for_inbound_resolver_endpoint").(bool) {
+				input.DnsOptions = &ec2.DnsOptionsSpecification{
+					PrivateDnsOnlyForInboundResolverEndpoint: aws.Bool(false),
+				}
+			}
 		}
 
 		input.AddRouteTableIds, input.RemoveRouteTableIds = flattenAddAndRemoveStringLists(d, "route_table_ids")
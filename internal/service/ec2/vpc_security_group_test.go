@@ -1159,6 +1159,7 @@ func TestAccVPCSecurityGroup_allowAll(t *testing.T) {
 					testAccCheckSecurityGroupExists(ctx, resourceName, &group),
 				),
 			},
+			// Rules managed by aws_security_group_rule import cleanly when ingress and egress are left unconfigured.
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
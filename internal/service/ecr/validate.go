@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+type lifecyclePolicyDocument struct {
+	Rules []*lifecyclePolicyDocumentRule `json:"rules"`
+}
+
+type lifecyclePolicyDocumentRule struct {
+	Action       *lifecyclePolicyDocumentRuleAction    `json:"action"`
+	RulePriority *int64                                `json:"rulePriority"`
+	Selection    *lifecyclePolicyDocumentRuleSelection `json:"selection"`
+}
+
+type lifecyclePolicyDocumentRuleAction struct {
+	Type *string `json:"type"`
+}
+
+type lifecyclePolicyDocumentRuleSelection struct {
+	CountNumber *int64  `json:"countNumber"`
+	CountType   *string `json:"countType"`
+	CountUnit   *string `json:"countUnit"`
+	TagStatus   *string `json:"tagStatus"`
+}
+
+// validateLifecyclePolicy checks a lifecycle policy document against the rules enforced by ECR.
+// See https://docs.aws.amazon.com/AmazonECR/latest/userguide/LifecyclePolicies.html#lifecycle_policy_parameters.
+func validateLifecyclePolicy(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	invalid := func(format string, a ...interface{}) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid lifecycle policy",
+			Detail:        fmt.Sprintf(format, a...),
+			AttributePath: path,
+		})
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		invalid("expected type to be string")
+		return diags
+	}
+
+	// Malformed JSON is reported by validation.StringIsJSON.
+	if !json.Valid([]byte(s)) {
+		return diags
+	}
+
+	var policy lifecyclePolicyDocument
+
+	if err := json.Unmarshal([]byte(s), &policy); err != nil {
+		invalid("policy is not a valid lifecycle policy JSON document: %s", err)
+		return diags
+	}
+
+	if len(policy.Rules) == 0 {
+		invalid("policy must contain at least one rule")
+		return diags
+	}
+
+	var maxPriority int64
+	anyRulePriorities := map[int]int64{}
+	priorities := map[int64]int{}
+
+	for i, rule := range policy.Rules {
+		if rule == nil {
+			invalid("rules[%d]: rule must not be null", i)
+			continue
+		}
+
+		if rule.RulePriority == nil {
+			invalid("rules[%d]: rulePriority is required", i)
+		} else if priority := aws.Int64Value(rule.RulePriority); priority < 1 {
+			invalid("rules[%d]: rulePriority must be at least 1, got %d", i, priority)
+		} else if j, ok := priorities[priority]; ok {
+			invalid("rules[%d]: rulePriority %d is already used by rules[%d]", i, priority, j)
+		} else {
+			priorities[priority] = i
+			if priority > maxPriority {
+				maxPriority = priority
+			}
+		}
+
+		if rule.Action == nil {
+			invalid("rules[%d]: action is required", i)
+		} else if actionType := aws.StringValue(rule.Action.Type); actionType != "expire" {
+			invalid("rules[%d]: action.type must be %q, got %q", i, "expire", actionType)
+		}
+
+		selection := rule.Selection
+		if selection == nil {
+			invalid("rules[%d]: selection is required", i)
+			continue
+		}
+
+		switch tagStatus := aws.StringValue(selection.TagStatus); tagStatus {
+		case "any":
+			if rule.RulePriority != nil {
+				anyRulePriorities[i] = aws.Int64Value(rule.RulePriority)
+			}
+		case "tagged", "untagged":
+		default:
+			invalid("rules[%d]: selection.tagStatus must be one of %q, %q or %q, got %q", i, "tagged", "untagged", "any", tagStatus)
+		}
+
+		switch countType := aws.StringValue(selection.CountType); countType {
+		case "imageCountMoreThan":
+			if selection.CountUnit != nil {
+				invalid("rules[%d]: selection.countUnit must not be set when selection.countType is %q", i, countType)
+			}
+		case "sinceImagePushed":
+			if countUnit := aws.StringValue(selection.CountUnit); countUnit != "days" {
+				invalid("rules[%d]: selection.countUnit must be %q when selection.countType is %q, got %q", i, "days", countType, countUnit)
+			}
+		default:
+			invalid("rules[%d]: selection.countType must be one of %q or %q, got %q", i, "imageCountMoreThan", "sinceImagePushed", countType)
+		}
+
+		if selection.CountNumber == nil {
+			invalid("rules[%d]: selection.countNumber is required", i)
+		} else if countNumber := aws.Int64Value(selection.CountNumber); countNumber < 1 {
+			invalid("rules[%d]: selection.countNumber must be at least 1, got %d", i, countNumber)
+		}
+	}
+
+	for i, priority := range anyRulePriorities {
+		if priority != maxPriority {
+			invalid("rules[%d]: a rule with selection.tagStatus %q must have the highest rulePriority (%d), got %d", i, "any", maxPriority, priority)
+		}
+	}
+
+	return diags
+}
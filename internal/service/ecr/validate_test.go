@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestValidateLifecyclePolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy string
+		errors []string
+	}{
+		"valid single rule": {
+			policy: `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":14},"action":{"type":"expire"}}]}`,
+		},
+		"valid multiple rules with gaps": {
+			policy: `{"rules":[
+				{"rulePriority":10,"selection":{"tagStatus":"any","countType":"imageCountMoreThan","countNumber":100},"action":{"type":"expire"}},
+				{"rulePriority":1,"selection":{"tagStatus":"tagged","tagPrefixList":["v"],"countType":"imageCountMoreThan","countNumber":30},"action":{"type":"expire"}}
+			]}`,
+		},
+		"invalid JSON is reported by StringIsJSON": {
+			policy: `{"rules":`,
+		},
+		"wrong types": {
+			policy: `{"rules":[{"rulePriority":"1"}]}`,
+			errors: []string{"not a valid lifecycle policy JSON document"},
+		},
+		"no rules": {
+			policy: `{"rules":[]}`,
+			errors: []string{"at least one rule"},
+		},
+		"null rule": {
+			policy: `{"rules":[null]}`,
+			errors: []string{"rules[0]: rule must not be null"},
+		},
+		"missing everything": {
+			policy: `{"rules":[{}]}`,
+			errors: []string{
+				"rules[0]: rulePriority is required",
+				"rules[0]: action is required",
+				"rules[0]: selection is required",
+			},
+		},
+		"rulePriority below 1": {
+			policy: `{"rules":[{"rulePriority":0,"selection":{"tagStatus":"untagged","countType":"imageCountMoreThan","countNumber":1},"action":{"type":"expire"}}]}`,
+			errors: []string{"rules[0]: rulePriority must be at least 1, got 0"},
+		},
+		"duplicate rulePriority": {
+			policy: `{"rules":[
+				{"rulePriority":1,"selection":{"tagStatus":"untagged","countType":"imageCountMoreThan","countNumber":1},"action":{"type":"expire"}},
+				{"rulePriority":1,"selection":{"tagStatus":"tagged","countType":"imageCountMoreThan","countNumber":1},"action":{"type":"expire"}}
+			]}`,
+			errors: []string{"rules[1]: rulePriority 1 is already used by rules[0]"},
+		},
+		"unsupported action type": {
+			policy: `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"untagged","countType":"imageCountMoreThan","countNumber":1},"action":{"type":"archive"}}]}`,
+			errors: []string{`rules[0]: action.type must be "expire", got "archive"`},
+		},
+		"unsupported tagStatus": {
+			policy: `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"all","countType":"imageCountMoreThan","countNumber":1},"action":{"type":"expire"}}]}`,
+			errors: []string{`rules[0]: selection.tagStatus must be one of "tagged", "untagged" or "any", got "all"`},
+		},
+		"unsupported countType": {
+			policy: `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"untagged","countType":"imageCountLessThan","countNumber":1},"action":{"type":"expire"}}]}`,
+			errors: []string{`rules[0]: selection.countType must be one of "imageCountMoreThan" or "sinceImagePushed", got "imageCountLessThan"`},
+		},
+		"countUnit with imageCountMoreThan": {
+			policy: `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"untagged","countType":"imageCountMoreThan","countUnit":"days","countNumber":1},"action":{"type":"expire"}}]}`,
+			errors: []string{`rules[0]: selection.countUnit must not be set when selection.countType is "imageCountMoreThan"`},
+		},
+		"missing countUnit with sinceImagePushed": {
+			policy: `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countNumber":1},"action":{"type":"expire"}}]}`,
+			errors: []string{`rules[0]: selection.countUnit must be "days" when selection.countType is "sinceImagePushed", got ""`},
+		},
+		"missing countNumber": {
+			policy: `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"untagged","countType":"imageCountMoreThan"},"action":{"type":"expire"}}]}`,
+			errors: []string{"rules[0]: selection.countNumber is required"},
+		},
+		"countNumber below 1": {
+			policy: `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"untagged","countType":"imageCountMoreThan","countNumber":0},"action":{"type":"expire"}}]}`,
+			errors: []string{"rules[0]: selection.countNumber must be at least 1, got 0"},
+		},
+		"any rule not last": {
+			policy: `{"rules":[
+				{"rulePriority":1,"selection":{"tagStatus":"any","countType":"imageCountMoreThan","countNumber":1},"action":{"type":"expire"}},
+				{"rulePriority":2,"selection":{"tagStatus":"untagged","countType":"imageCountMoreThan","countNumber":1},"action":{"type":"expire"}}
+			]}`,
+			errors: []string{`rules[0]: a rule with selection.tagStatus "any" must have the highest rulePriority (2), got 1`},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateLifecyclePolicy(testCase.policy, cty.GetAttrPath("policy"))
+
+			if got, want := len(diags), len(testCase.errors); got != want {
+				t.Fatalf("got %d diagnostics, want %d: %v", got, want, diags)
+			}
+
+			for i, want := range testCase.errors {
+				if got := diags[i].Detail; !strings.Contains(got, want) {
+					t.Errorf("diagnostic %d: got %q, want it to contain %q", i, got, want)
+				}
+			}
+		})
+	}
+}
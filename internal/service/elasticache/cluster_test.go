@@ -275,6 +275,51 @@ func TestAccElastiCacheCluster_ipDiscovery(t *testing.T) {
 	})
 }
 
+func TestAccElastiCacheCluster_ipDiscoveryRedis(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var ec elasticache.CacheCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticache.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_ipDiscoveryRedis(rName, "ipv4", "dual_stack"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &ec),
+					resource.TestCheckResourceAttr(resourceName, "engine", "redis"),
+					resource.TestCheckResourceAttr(resourceName, "ip_discovery", "ipv4"),
+					resource.TestCheckResourceAttr(resourceName, "network_type", "dual_stack"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"apply_immediately",
+				},
+			},
+			{
+				Config: testAccClusterConfig_ipDiscoveryRedis(rName, "ipv6", "dual_stack"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &ec),
+					resource.TestCheckResourceAttr(resourceName, "ip_discovery", "ipv6"),
+					resource.TestCheckResourceAttr(resourceName, "network_type", "dual_stack"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccElastiCacheCluster_port(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1669,6 +1714,41 @@ resource "aws_elasticache_cluster" "test" {
 `, rName, ipDiscovery, networkType))
 }
 
+func testAccClusterConfig_ipDiscoveryRedis(rName, ipDiscovery, networkType string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnetsIPv6(rName, 1), fmt.Sprintf(`
+resource "aws_elasticache_subnet_group" "test" {
+  name        = %[1]q
+  description = %[1]q
+  subnet_ids  = aws_subnet.test[*].id
+}
+
+resource "aws_security_group" "test" {
+  name        = %[1]q
+  description = %[1]q
+  vpc_id      = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_elasticache_cluster" "test" {
+  cluster_id        = %[1]q
+  engine            = "redis"
+  engine_version    = "7.1"
+  node_type         = "cache.t3.small"
+  num_cache_nodes   = 1
+  ip_discovery      = %[2]q
+  network_type      = %[3]q
+  apply_immediately = true
+
+  subnet_group_name  = aws_elasticache_subnet_group.test.name
+  security_group_ids = [aws_security_group.test.id]
+  availability_zone  = data.aws_availability_zones.available.names[0]
+}
+`, rName, ipDiscovery, networkType))
+}
+
 func testAccClusterConfig_port(rName string, port int) string {
 	return fmt.Sprintf(`
 resource "aws_elasticache_cluster" "test" {
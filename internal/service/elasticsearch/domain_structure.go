@@ -211,9 +211,13 @@ func flattenAutoTuneMaintenanceSchedules(autoTuneMaintenanceSchedules []*elastic
 	for _, autoTuneMaintenanceSchedule := range autoTuneMaintenanceSchedules {
 		m := map[string]interface{}{}
 
-		m["start_at"] = aws.TimeValue(autoTuneMaintenanceSchedule.StartAt).Format(time.RFC3339)
+		if v := autoTuneMaintenanceSchedule.StartAt; v != nil {
+			m["start_at"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
 
-		m["duration"] = []interface{}{flattenAutoTuneMaintenanceScheduleDuration(autoTuneMaintenanceSchedule.Duration)}
+		if v := autoTuneMaintenanceSchedule.Duration; v != nil {
+			m["duration"] = []interface{}{flattenAutoTuneMaintenanceScheduleDuration(v)}
+		}
 
 		m["cron_expression_for_recurrence"] = aws.StringValue(autoTuneMaintenanceSchedule.CronExpressionForRecurrence)
 
@@ -1079,6 +1079,14 @@ func customizeDiffTargetGroupTargetTypeNotLambda(_ context.Context, diff *schema
 		)
 	}
 
+	if v := config.GetAttr("lambda_multi_value_headers_enabled"); v.IsKnown() && !v.IsNull() && v.True() {
+		return fmt.Errorf("Attribute %q cannot be enabled when %q is %q.",
+			"lambda_multi_value_headers_enabled",
+			"target_type",
+			targetType,
+		)
+	}
+
 	return nil
 }
 
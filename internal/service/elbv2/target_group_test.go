@@ -2076,6 +2076,24 @@ func TestAccELBV2TargetGroup_ALBAlias_lambdaMultiValueHeadersEnabled(t *testing.
 	})
 }
 
+func TestAccELBV2TargetGroup_ALBAlias_lambdaMultiValueHeadersEnabledNotLambda(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elbv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTargetGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTargetGroupConfig_albLambdaMultiValueHeadersEnabledNotLambda(rName),
+				ExpectError: regexache.MustCompile(`Attribute "lambda_multi_value_headers_enabled" cannot be enabled when "target_type" is "instance".`),
+			},
+		},
+	})
+}
+
 func TestAccELBV2TargetGroup_ALBAlias_missing(t *testing.T) {
 	t.Parallel()
 
@@ -5481,6 +5499,19 @@ resource "aws_alb_target_group" "test" {
 `, lambdaMultiValueHadersEnabled, rName)
 }
 
+func testAccTargetGroupConfig_albLambdaMultiValueHeadersEnabledNotLambda(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_alb_target_group" "test" {
+  lambda_multi_value_headers_enabled = true
+  name                               = %[1]q
+  port                               = 443
+  protocol                           = "HTTPS"
+  vpc_id                             = aws_vpc.test.id
+  target_type                        = "instance"
+}
+`, rName))
+}
+
 func testAccTargetGroupConfig_albLoadBalancingAlgorithm(rName string, nonDefault bool, algoType string) string {
 	var algoTypeParam string
 
@@ -273,6 +273,7 @@ func TestAccEventsConnection_oAuth(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.authorization_endpoint", authorizationEndpoint),
 					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.http_method", httpMethod),
 					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.client_parameters.0.client_id", clientID),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.client_parameters.0.client_secret", clientSecret),
 					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.oauth_http_parameters.0.body.0.key", bodyKey),
 					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.oauth_http_parameters.0.body.0.is_value_secret", strconv.FormatBool(bodyIsSecretValue)),
 					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.oauth_http_parameters.0.header.0.key", headerKey),
@@ -281,6 +282,27 @@ func TestAccEventsConnection_oAuth(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.oauth.0.oauth_http_parameters.0.query_string.0.is_value_secret", strconv.FormatBool(queryStringIsSecretValue)),
 				),
 			},
+			{
+				Config: testAccConnectionConfig_oauth(
+					name,
+					description,
+					authorizationType,
+					authorizationEndpoint,
+					httpMethod,
+					clientID,
+					clientSecret,
+					bodyKey,
+					bodyValue,
+					bodyIsSecretValue,
+					headerKey,
+					headerValue,
+					headerIsSecretValue,
+					queryStringKey,
+					queryStringValue,
+					queryStringIsSecretValue,
+				),
+				PlanOnly: true,
+			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
@@ -648,6 +648,43 @@ func TestAccEventsRule_eventBusARN(t *testing.T) {
 	})
 }
 
+func TestAccEventsRule_eventBusARNCrossAccount(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v eventbridge.DescribeRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_rule.test"
+	eventBusName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig_busARNCrossAccount(rName, eventBusName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "event_bus_name", "aws_cloudwatch_event_bus.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				Config:   testAccRuleConfig_busARNCrossAccount(rName, eventBusName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccEventsRule_migrateV0(t *testing.T) {
 	const resourceName = "aws_cloudwatch_event_rule.test"
 
@@ -1106,6 +1143,64 @@ PATTERN
 `, rName, eventBusName)
 }
 
+func testAccRuleConfig_busARNCrossAccount(rName, eventBusName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_cloudwatch_event_bus" "test" {
+  provider = "awsalternate"
+
+  name = %[2]q
+}
+
+resource "aws_cloudwatch_event_bus_policy" "test" {
+  provider = "awsalternate"
+
+  event_bus_name = aws_cloudwatch_event_bus.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "AllowManageRules"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action = [
+        "events:DeleteRule",
+        "events:DescribeRule",
+        "events:DisableRule",
+        "events:EnableRule",
+        "events:ListTagsForResource",
+        "events:PutRule",
+        "events:TagResource",
+        "events:UntagResource",
+      ]
+      Resource = "arn:${data.aws_partition.current.partition}:events:*:*:rule/${aws_cloudwatch_event_bus.test.name}/*"
+      Condition = {
+        StringEqualsIfExists = {
+          "events:creatorAccount" = data.aws_caller_identity.current.account_id
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_cloudwatch_event_rule" "test" {
+  name           = %[1]q
+  event_bus_name = aws_cloudwatch_event_bus.test.arn
+
+  event_pattern = jsonencode({
+    source = ["aws.ec2"]
+  })
+
+  depends_on = [aws_cloudwatch_event_bus_policy.test]
+}
+`, rName, eventBusName))
+}
+
 func testAccRuleConfig_busARN(rName, eventBusName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_bus" "test" {
@@ -41,6 +41,7 @@ const (
 	destinationTypeOpenSearch           destinationType = "opensearch"
 	destinationTypeOpenSearchServerless destinationType = "opensearchserverless"
 	destinationTypeRedshift             destinationType = "redshift"
+	destinationTypeSnowflake            destinationType = "snowflake"
 	destinationTypeSplunk               destinationType = "splunk"
 )
 
@@ -52,6 +53,7 @@ func (destinationType) Values() []destinationType {
 		destinationTypeOpenSearch,
 		destinationTypeOpenSearchServerless,
 		destinationTypeRedshift,
+		destinationTypeSnowflake,
 		destinationTypeSplunk,
 	}
 }
@@ -1128,6 +1130,122 @@ func resourceDeliveryStream() *schema.Resource {
 						},
 					},
 				},
+				"snowflake_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"account_url": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"cloudwatch_logging_options": cloudWatchLoggingOptionsSchema(),
+							"content_column_name": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+							"data_loading_option": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Default:          types.SnowflakeDataLoadingOptionJsonMapping,
+								ValidateDiagFunc: enum.Validate[types.SnowflakeDataLoadingOption](),
+							},
+							"database": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+							"key_passphrase": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Sensitive:    true,
+								ValidateFunc: validation.StringLenBetween(7, 255),
+							},
+							"metadata_column_name": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+							"private_key": {
+								Type:      schema.TypeString,
+								Required:  true,
+								Sensitive: true,
+							},
+							"processing_configuration": processingConfigurationSchema(),
+							"retry_duration": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      60,
+								ValidateFunc: validation.IntBetween(0, 7200),
+							},
+							"role_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"s3_backup_mode": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Default:          types.SnowflakeS3BackupModeFailedDataOnly,
+								ValidateDiagFunc: enum.Validate[types.SnowflakeS3BackupMode](),
+							},
+							"s3_configuration": s3ConfigurationSchema(),
+							"schema": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+							"snowflake_role_configuration": {
+								Type:             schema.TypeList,
+								Optional:         true,
+								Computed:         true,
+								MaxItems:         1,
+								DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"enabled": {
+											Type:     schema.TypeBool,
+											Optional: true,
+											Default:  false,
+										},
+										"snowflake_role": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringLenBetween(1, 255),
+										},
+									},
+								},
+							},
+							"snowflake_vpc_configuration": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"private_link_vpce_id": {
+											Type:     schema.TypeString,
+											Required: true,
+											ForceNew: true,
+										},
+									},
+								},
+							},
+							"table": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+							"user": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+						},
+					},
+				},
 				"splunk_configuration": {
 					Type:     schema.TypeList,
 					Optional: true,
@@ -1205,6 +1323,7 @@ func resourceDeliveryStream() *schema.Resource {
 					destinationTypeOpenSearch:           "opensearch_configuration",
 					destinationTypeOpenSearchServerless: "opensearchserverless_configuration",
 					destinationTypeRedshift:             "redshift_configuration",
+					destinationTypeSnowflake:            "snowflake_configuration",
 					destinationTypeSplunk:               "splunk_configuration",
 				}[destination]
 
@@ -1262,6 +1381,10 @@ func resourceDeliveryStreamCreate(ctx context.Context, d *schema.ResourceData, m
 		if v, ok := d.GetOk("redshift_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			input.RedshiftDestinationConfiguration = expandRedshiftDestinationConfiguration(v.([]interface{})[0].(map[string]interface{}))
 		}
+	case destinationTypeSnowflake:
+		if v, ok := d.GetOk("snowflake_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.SnowflakeDestinationConfiguration = expandSnowflakeDestinationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
 	case destinationTypeSplunk:
 		if v, ok := d.GetOk("splunk_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			input.SplunkDestinationConfiguration = expandSplunkDestinationConfiguration(v.([]interface{})[0].(map[string]interface{}))
@@ -1383,6 +1506,13 @@ func resourceDeliveryStreamRead(ctx context.Context, d *schema.ResourceData, met
 			if err := d.Set("redshift_configuration", flattenRedshiftDestinationDescription(destination.RedshiftDestinationDescription, configuredPassword)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "setting redshift_configuration: %s", err)
 			}
+		case destination.SnowflakeDestinationDescription != nil:
+			d.Set("destination", destinationTypeSnowflake)
+			configuredKeyPassphrase := d.Get("snowflake_configuration.0.key_passphrase").(string)
+			configuredPrivateKey := d.Get("snowflake_configuration.0.private_key").(string)
+			if err := d.Set("snowflake_configuration", flattenSnowflakeDestinationDescription(destination.SnowflakeDestinationDescription, configuredKeyPassphrase, configuredPrivateKey)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting snowflake_configuration: %s", err)
+			}
 		case destination.SplunkDestinationDescription != nil:
 			d.Set("destination", destinationTypeSplunk)
 			if err := d.Set("splunk_configuration", flattenSplunkDestinationDescription(destination.SplunkDestinationDescription)); err != nil {
@@ -1438,6 +1568,10 @@ func resourceDeliveryStreamUpdate(ctx context.Context, d *schema.ResourceData, m
 			if v, ok := d.GetOk("redshift_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.RedshiftDestinationUpdate = expandRedshiftDestinationUpdate(v.([]interface{})[0].(map[string]interface{}))
 			}
+		case destinationTypeSnowflake:
+			if v, ok := d.GetOk("snowflake_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.SnowflakeDestinationUpdate = expandSnowflakeDestinationUpdate(v.([]interface{})[0].(map[string]interface{}))
+			}
 		case destinationTypeSplunk:
 			if v, ok := d.GetOk("splunk_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.SplunkDestinationUpdate = expandSplunkDestinationUpdate(v.([]interface{})[0].(map[string]interface{}))
@@ -2496,6 +2630,138 @@ func expandAmazonOpenSearchServerlessDestinationUpdate(oss map[string]interface{
 	return update
 }
 
+func expandSnowflakeDestinationConfiguration(tfMap map[string]interface{}) *types.SnowflakeDestinationConfiguration {
+	roleARN := tfMap["role_arn"].(string)
+	apiObject := &types.SnowflakeDestinationConfiguration{
+		AccountUrl:      aws.String(tfMap["account_url"].(string)),
+		Database:        aws.String(tfMap["database"].(string)),
+		PrivateKey:      aws.String(tfMap["private_key"].(string)),
+		RetryOptions:    expandSnowflakeRetryOptions(tfMap),
+		RoleARN:         aws.String(roleARN),
+		S3Configuration: expandS3DestinationConfiguration(tfMap["s3_configuration"].([]interface{})),
+		Schema:          aws.String(tfMap["schema"].(string)),
+		Table:           aws.String(tfMap["table"].(string)),
+		User:            aws.String(tfMap["user"].(string)),
+	}
+
+	if _, ok := tfMap["cloudwatch_logging_options"]; ok {
+		apiObject.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(tfMap)
+	}
+
+	if v, ok := tfMap["content_column_name"].(string); ok && v != "" {
+		apiObject.ContentColumnName = aws.String(v)
+	}
+
+	if v, ok := tfMap["data_loading_option"].(string); ok && v != "" {
+		apiObject.DataLoadingOption = types.SnowflakeDataLoadingOption(v)
+	}
+
+	if v, ok := tfMap["key_passphrase"].(string); ok && v != "" {
+		apiObject.KeyPassphrase = aws.String(v)
+	}
+
+	if v, ok := tfMap["metadata_column_name"].(string); ok && v != "" {
+		apiObject.MetaDataColumnName = aws.String(v)
+	}
+
+	if _, ok := tfMap["processing_configuration"]; ok {
+		apiObject.ProcessingConfiguration = expandProcessingConfiguration(tfMap, destinationTypeSnowflake, roleARN)
+	}
+
+	if v, ok := tfMap["s3_backup_mode"].(string); ok && v != "" {
+		apiObject.S3BackupMode = types.SnowflakeS3BackupMode(v)
+	}
+
+	if v, ok := tfMap["snowflake_role_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SnowflakeRoleConfiguration = expandSnowflakeRoleConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["snowflake_vpc_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SnowflakeVpcConfiguration = expandSnowflakeVPCConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSnowflakeDestinationUpdate(tfMap map[string]interface{}) *types.SnowflakeDestinationUpdate {
+	roleARN := tfMap["role_arn"].(string)
+	apiObject := &types.SnowflakeDestinationUpdate{
+		AccountUrl:   aws.String(tfMap["account_url"].(string)),
+		Database:     aws.String(tfMap["database"].(string)),
+		PrivateKey:   aws.String(tfMap["private_key"].(string)),
+		RetryOptions: expandSnowflakeRetryOptions(tfMap),
+		RoleARN:      aws.String(roleARN),
+		S3Update:     expandS3DestinationUpdate(tfMap["s3_configuration"].([]interface{})),
+		Schema:       aws.String(tfMap["schema"].(string)),
+		Table:        aws.String(tfMap["table"].(string)),
+		User:         aws.String(tfMap["user"].(string)),
+	}
+
+	if _, ok := tfMap["cloudwatch_logging_options"]; ok {
+		apiObject.CloudWatchLoggingOptions = expandCloudWatchLoggingOptions(tfMap)
+	}
+
+	if v, ok := tfMap["content_column_name"].(string); ok && v != "" {
+		apiObject.ContentColumnName = aws.String(v)
+	}
+
+	if v, ok := tfMap["data_loading_option"].(string); ok && v != "" {
+		apiObject.DataLoadingOption = types.SnowflakeDataLoadingOption(v)
+	}
+
+	if v, ok := tfMap["key_passphrase"].(string); ok && v != "" {
+		apiObject.KeyPassphrase = aws.String(v)
+	}
+
+	if v, ok := tfMap["metadata_column_name"].(string); ok && v != "" {
+		apiObject.MetaDataColumnName = aws.String(v)
+	}
+
+	if _, ok := tfMap["processing_configuration"]; ok {
+		apiObject.ProcessingConfiguration = expandProcessingConfiguration(tfMap, destinationTypeSnowflake, roleARN)
+	}
+
+	if v, ok := tfMap["s3_backup_mode"].(string); ok && v != "" {
+		apiObject.S3BackupMode = types.SnowflakeS3BackupMode(v)
+	}
+
+	if v, ok := tfMap["snowflake_role_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SnowflakeRoleConfiguration = expandSnowflakeRoleConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSnowflakeRetryOptions(tfMap map[string]interface{}) *types.SnowflakeRetryOptions {
+	apiObject := &types.SnowflakeRetryOptions{}
+
+	if v, ok := tfMap["retry_duration"].(int); ok {
+		apiObject.DurationInSeconds = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func expandSnowflakeRoleConfiguration(tfMap map[string]interface{}) *types.SnowflakeRoleConfiguration {
+	apiObject := &types.SnowflakeRoleConfiguration{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["snowflake_role"].(string); ok && v != "" {
+		apiObject.SnowflakeRole = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSnowflakeVPCConfiguration(tfMap map[string]interface{}) *types.SnowflakeVpcConfiguration {
+	apiObject := &types.SnowflakeVpcConfiguration{
+		PrivateLinkVpceId: aws.String(tfMap["private_link_vpce_id"].(string)),
+	}
+
+	return apiObject
+}
+
 func expandSplunkDestinationConfiguration(splunk map[string]interface{}) *types.SplunkDestinationConfiguration {
 	configuration := &types.SplunkDestinationConfiguration{
 		HECToken:                          aws.String(splunk["hec_token"].(string)),
@@ -3108,6 +3374,64 @@ func flattenRedshiftDestinationDescription(description *types.RedshiftDestinatio
 	return []map[string]interface{}{m}
 }
 
+func flattenSnowflakeDestinationDescription(description *types.SnowflakeDestinationDescription, configuredKeyPassphrase, configuredPrivateKey string) []map[string]interface{} {
+	if description == nil {
+		return []map[string]interface{}{}
+	}
+
+	roleARN := aws.ToString(description.RoleARN)
+	m := map[string]interface{}{
+		"account_url":                  aws.ToString(description.AccountUrl),
+		"cloudwatch_logging_options":   flattenCloudWatchLoggingOptions(description.CloudWatchLoggingOptions),
+		"content_column_name":          aws.ToString(description.ContentColumnName),
+		"data_loading_option":          description.DataLoadingOption,
+		"database":                     aws.ToString(description.Database),
+		"key_passphrase":               configuredKeyPassphrase,
+		"metadata_column_name":         aws.ToString(description.MetaDataColumnName),
+		"private_key":                  configuredPrivateKey,
+		"processing_configuration":     flattenProcessingConfiguration(description.ProcessingConfiguration, destinationTypeSnowflake, roleARN),
+		"role_arn":                     roleARN,
+		"s3_backup_mode":               description.S3BackupMode,
+		"s3_configuration":             flattenS3DestinationDescription(description.S3DestinationDescription),
+		"schema":                       aws.ToString(description.Schema),
+		"snowflake_role_configuration": flattenSnowflakeRoleConfiguration(description.SnowflakeRoleConfiguration),
+		"snowflake_vpc_configuration":  flattenSnowflakeVPCConfiguration(description.SnowflakeVpcConfiguration),
+		"table":                        aws.ToString(description.Table),
+		"user":                         aws.ToString(description.User),
+	}
+
+	if description.RetryOptions != nil {
+		m["retry_duration"] = int(aws.ToInt32(description.RetryOptions.DurationInSeconds))
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenSnowflakeRoleConfiguration(apiObject *types.SnowflakeRoleConfiguration) []map[string]interface{} {
+	if apiObject == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enabled":        aws.ToBool(apiObject.Enabled),
+		"snowflake_role": aws.ToString(apiObject.SnowflakeRole),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenSnowflakeVPCConfiguration(apiObject *types.SnowflakeVpcConfiguration) []map[string]interface{} {
+	if apiObject == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"private_link_vpce_id": aws.ToString(apiObject.PrivateLinkVpceId),
+	}
+
+	return []map[string]interface{}{m}
+}
+
 func flattenSplunkDestinationDescription(description *types.SplunkDestinationDescription) []map[string]interface{} {
 	if description == nil {
 		return []map[string]interface{}{}
@@ -268,6 +268,89 @@ func TestAccFISExperimentTemplate_eks(t *testing.T) {
 	})
 }
 
+func TestAccFISExperimentTemplate_ecs(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fis_experiment_template.test"
+	var conf types.ExperimentTemplate
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, fis.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExperimentTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentTemplateConfig_basic(rName, "An experiment template for testing", "test-action-1", "", "aws:ecs:stop-task", "Tasks", "to-stop", "aws:ecs:task", "ALL", "env", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccExperimentTemplateExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "action.0.action_id", "aws:ecs:stop-task"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.target.0.key", "Tasks"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.target.0.value", "to-stop"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.name", "to-stop"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.resource_type", "aws:ecs:task"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.selection_mode", "ALL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFISExperimentTemplate_rds(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fis_experiment_template.test"
+	var conf types.ExperimentTemplate
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, fis.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExperimentTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentTemplateConfig_basic(rName, "An experiment template for testing", "test-action-1", "", "aws:rds:failover-db-cluster", "Clusters", "to-failover", "aws:rds:cluster", "ALL", "env", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccExperimentTemplateExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "action.0.action_id", "aws:rds:failover-db-cluster"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.target.0.key", "Clusters"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.target.0.value", "to-failover"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.name", "to-failover"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.resource_type", "aws:rds:cluster"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.selection_mode", "ALL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccExperimentTemplateConfig_basic(rName, "An experiment template for testing", "test-action-1", "", "aws:rds:reboot-db-instances", "DBInstances", "to-reboot", "aws:rds:db", "ALL", "env", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccExperimentTemplateExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "action.0.action_id", "aws:rds:reboot-db-instances"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.target.0.key", "DBInstances"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.target.0.value", "to-reboot"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.name", "to-reboot"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.resource_type", "aws:rds:db"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.selection_mode", "ALL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccFISExperimentTemplate_ebs(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
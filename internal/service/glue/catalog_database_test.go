@@ -194,6 +194,39 @@ func TestAccGlueCatalogDatabase_targetDatabaseWithRegion(t *testing.T) {
 	})
 }
 
+func TestAccGlueCatalogDatabase_federatedDatabase(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_glue_catalog_database.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	// Federation requires an existing connection to a source that Glue can federate, e.g. an Athena federation connection.
+	connectionName := acctest.SkipIfEnvVarNotSet(t, "GLUE_FEDERATED_CONNECTION_NAME")
+	identifier := acctest.SkipIfEnvVarNotSet(t, "GLUE_FEDERATED_IDENTIFIER")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatabaseDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCatalogDatabaseConfig_federated(rName, connectionName, identifier),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCatalogDatabaseExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "federated_database.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "federated_database.0.connection_name", connectionName),
+					resource.TestCheckResourceAttr(resourceName, "federated_database.0.identifier", identifier),
+					resource.TestCheckResourceAttr(resourceName, "location_uri", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccGlueCatalogDatabase_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_glue_catalog_database.test"
@@ -375,6 +408,19 @@ resource "aws_glue_catalog_database" "test2" {
 `, rName, acctest.AlternateRegion()))
 }
 
+func testAccCatalogDatabaseConfig_federated(rName, connectionName, identifier string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+
+  federated_database {
+    connection_name = %[2]q
+    identifier      = %[3]q
+  }
+}
+`, rName, connectionName, identifier)
+}
+
 func testAccCatalogDatabaseConfig_permission(rName, permission string) string {
 	return fmt.Sprintf(`
 resource "aws_glue_catalog_database" "test" {
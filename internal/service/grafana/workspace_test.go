@@ -70,7 +70,7 @@ func testAccWorkspace_saml(t *testing.T) {
 
 func testAccWorkspace_vpc(t *testing.T) {
 	ctx := acctest.Context(t)
-	var v managedgrafana.WorkspaceDescription
+	var v1, v2, v3, v4 managedgrafana.WorkspaceDescription
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_grafana_workspace.test"
 
@@ -81,9 +81,9 @@ func testAccWorkspace_vpc(t *testing.T) {
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccWorkspaceConfig_vpc(rName, 2),
+				Config: testAccWorkspaceConfig_vpc(rName, 2, 1),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckWorkspaceExists(ctx, resourceName, &v),
+					testAccCheckWorkspaceExists(ctx, resourceName, &v1),
 					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.0.security_group_ids.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.0.subnet_ids.#", "2"),
@@ -95,18 +95,30 @@ func testAccWorkspace_vpc(t *testing.T) {
 				ImportStateVerify: true,
 			},
 			{
-				Config: testAccWorkspaceConfig_vpc(rName, 3),
+				Config: testAccWorkspaceConfig_vpc(rName, 3, 1),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckWorkspaceExists(ctx, resourceName, &v),
+					testAccCheckWorkspaceExists(ctx, resourceName, &v2),
+					testAccCheckWorkspaceNotRecreated(&v2, &v1),
 					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.0.security_group_ids.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.0.subnet_ids.#", "3"),
 				),
 			},
+			{
+				Config: testAccWorkspaceConfig_vpc(rName, 3, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkspaceExists(ctx, resourceName, &v3),
+					testAccCheckWorkspaceNotRecreated(&v3, &v2),
+					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.0.security_group_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.0.subnet_ids.#", "3"),
+				),
+			},
 			{
 				Config: testAccWorkspaceConfig_vpcRemoved(rName, 3),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckWorkspaceExists(ctx, resourceName, &v),
+					testAccCheckWorkspaceExists(ctx, resourceName, &v4),
+					testAccCheckWorkspaceNotRecreated(&v4, &v3),
 					resource.TestCheckResourceAttr(resourceName, "vpc_configuration.#", "0"),
 				),
 			},
@@ -772,9 +784,11 @@ resource "aws_grafana_workspace" "test" {
 `, rName))
 }
 
-func testAccWorkspaceConfig_vpc(rName string, subnets int) string {
+func testAccWorkspaceConfig_vpc(rName string, subnets, securityGroups int) string {
 	return acctest.ConfigCompose(testAccWorkspaceConfig_base(rName), acctest.ConfigVPCWithSubnets(rName, subnets), fmt.Sprintf(`
 resource "aws_security_group" "test" {
+  count = %[2]d
+
   description = %[1]q
   vpc_id      = aws_vpc.test.id
 }
@@ -787,10 +801,10 @@ resource "aws_grafana_workspace" "test" {
 
   vpc_configuration {
     subnet_ids         = aws_subnet.test[*].id
-    security_group_ids = [aws_security_group.test.id]
+    security_group_ids = aws_security_group.test[*].id
   }
 }
-`, rName))
+`, rName, securityGroups))
 }
 
 func testAccWorkspaceConfig_vpcRemoved(rName string, subnets int) string {
@@ -18,4 +18,5 @@ var (
 	FindAttachedUserPolicyByTwoPartKey  = findAttachedUserPolicyByTwoPartKey
 	FindEntitiesForPolicyByARN          = findEntitiesForPolicyByARN
 	FindPolicyByARN                     = findPolicyByARN
+	PolicyVersionIDsNewestFirst         = policyVersionIDsNewestFirst
 )
@@ -6,9 +6,11 @@ package iam
 import (
 	"context"
 	"net/url"
+	"sort"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -59,6 +61,16 @@ func DataSourcePolicy() *schema.Resource {
 				Computed: true,
 			},
 			"tags": tftags.TagsSchemaComputed(),
+			"version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"version_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -106,14 +118,19 @@ func dataSourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
+	versionID := aws.StringValue(policy.DefaultVersionId)
+	if v, ok := d.GetOk("version_id"); ok {
+		versionID = v.(string)
+	}
+
 	outputRaw, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout,
 		func() (interface{}, error) {
-			return findPolicyVersion(ctx, conn, arn, aws.StringValue(policy.DefaultVersionId))
+			return findPolicyVersion(ctx, conn, arn, versionID)
 		},
 	)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading IAM Policy (%s) default version: %s", arn, err)
+		return sdkdiag.AppendErrorf(diags, "reading IAM Policy (%s) version (%s): %s", arn, versionID, err)
 	}
 
 	policyDocument, err := url.QueryUnescape(aws.StringValue(outputRaw.(*iam.PolicyVersion).Document))
@@ -122,6 +139,33 @@ func dataSourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	d.Set("policy", policyDocument)
+	d.Set("version_id", versionID)
+
+	versions, err := findPolicyVersionsByARN(ctx, conn, arn)
+
+	switch {
+	case tfawserr.ErrCodeContains(err, "AccessDenied"):
+		diags = sdkdiag.AppendWarningf(diags, "listing IAM Policy (%s) versions, version_ids will be empty: %s", arn, err)
+		d.Set("version_ids", nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading IAM Policy (%s) versions: %s", arn, err)
+	default:
+		d.Set("version_ids", policyVersionIDsNewestFirst(versions))
+	}
 
 	return diags
 }
+
+// policyVersionIDsNewestFirst returns the IDs of the specified policy versions ordered by creation date, newest first.
+func policyVersionIDsNewestFirst(versions []*iam.PolicyVersion) []string {
+	sort.SliceStable(versions, func(i, j int) bool {
+		return aws.TimeValue(versions[i].CreateDate).After(aws.TimeValue(versions[j].CreateDate))
+	})
+
+	versionIDs := make([]string, 0, len(versions))
+	for _, v := range versions {
+		versionIDs = append(versionIDs, aws.StringValue(v.VersionId))
+	}
+
+	return versionIDs
+}
@@ -6,14 +6,69 @@ package iam_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/google/go-cmp/cmp"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 )
 
+func TestPolicyVersionIDsNewestFirst(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	testCases := map[string]struct {
+		versions []*iam.PolicyVersion
+		expected []string
+	}{
+		"empty": {
+			versions: nil,
+			expected: []string{},
+		},
+		"single": {
+			versions: []*iam.PolicyVersion{
+				{VersionId: aws.String("v1"), CreateDate: aws.Time(now)},
+			},
+			expected: []string{"v1"},
+		},
+		"oldest first": {
+			versions: []*iam.PolicyVersion{
+				{VersionId: aws.String("v1"), CreateDate: aws.Time(now.Add(-2 * time.Hour))},
+				{VersionId: aws.String("v2"), CreateDate: aws.Time(now.Add(-1 * time.Hour))},
+				{VersionId: aws.String("v3"), CreateDate: aws.Time(now)},
+			},
+			expected: []string{"v3", "v2", "v1"},
+		},
+		"unordered": {
+			versions: []*iam.PolicyVersion{
+				{VersionId: aws.String("v4"), CreateDate: aws.Time(now.Add(-1 * time.Hour))},
+				{VersionId: aws.String("v6"), CreateDate: aws.Time(now)},
+				{VersionId: aws.String("v5"), CreateDate: aws.Time(now.Add(-30 * time.Minute))},
+			},
+			expected: []string{"v6", "v5", "v4"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfiam.PolicyVersionIDsNewestFirst(testCase.versions)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestAccIAMPolicyDataSource_arn(t *testing.T) {
 	ctx := acctest.Context(t)
 	datasourceName := "data.aws_iam_policy.test"
@@ -201,6 +256,38 @@ func TestAccIAMPolicyDataSource_nonExistent(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDataSource_versionID(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_iam_policy.test"
+	defaultDatasourceName := "data.aws_iam_policy.default"
+	resourceName := "aws_iam_policy.test"
+	policyName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyBaseDataSourceConfig(policyName, "/"),
+			},
+			{
+				Config: testAccPolicyDataSourceConfig_versionID(policyName, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(datasourceName, "version_id", "v1"),
+					resource.TestMatchResourceAttr(datasourceName, "policy", regexache.MustCompile(`ec2:Describe\*`)),
+					resource.TestCheckResourceAttr(datasourceName, "version_ids.#", "2"),
+					resource.TestCheckResourceAttr(datasourceName, "version_ids.0", "v2"),
+					resource.TestCheckResourceAttr(datasourceName, "version_ids.1", "v1"),
+					resource.TestCheckResourceAttr(defaultDatasourceName, "version_id", "v2"),
+					resource.TestMatchResourceAttr(defaultDatasourceName, "policy", regexache.MustCompile(`ec2:\*`)),
+				),
+			},
+		},
+	})
+}
+
 func testAccPolicyBaseDataSourceConfig(policyName, policyPath string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_policy" "test" {
@@ -321,3 +408,41 @@ data "aws_iam_policy" "test" {
 }
 `, policyPath))
 }
+
+func testAccPolicyDataSourceConfig_versionID(policyName, versionID string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  name        = %[1]q
+  path        = "/"
+  description = "My test policy"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": [
+        "ec2:*"
+      ],
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+data "aws_iam_policy" "test" {
+  arn        = aws_iam_policy.test.arn
+  version_id = %[2]q
+
+  depends_on = [aws_iam_policy.test]
+}
+
+data "aws_iam_policy" "default" {
+  arn = aws_iam_policy.test.arn
+
+  depends_on = [aws_iam_policy.test]
+}
+`, policyName, versionID)
+}
@@ -4,6 +4,7 @@
 package iam
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strings"
@@ -64,3 +65,103 @@ var validRolePolicyRole = validation.All(
 		return
 	},
 )
+
+var validAssumeRolePolicy = validation.All(
+	verify.ValidIAMPolicyJSON,
+	validPolicyGrammar,
+)
+
+// validPolicyGrammar performs a lightweight structural check of an IAM policy document
+// so that common mistakes are reported at plan time rather than by the IAM API.
+// JSON syntax errors are left to verify.ValidIAMPolicyJSON.
+func validPolicyGrammar(v interface{}, k string) (ws []string, es []error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &doc); err != nil {
+		return
+	}
+
+	raw, ok := doc["Statement"]
+	if !ok {
+		es = append(es, fmt.Errorf("%q contains an invalid policy: missing Statement", k))
+		return
+	}
+
+	var statements []interface{}
+	switch raw := raw.(type) {
+	case []interface{}:
+		statements = raw
+	case map[string]interface{}:
+		statements = []interface{}{raw}
+	default:
+		es = append(es, fmt.Errorf("%q contains an invalid policy: Statement must be an object or an array of objects", k))
+		return
+	}
+
+	for i, raw := range statements {
+		statement, ok := raw.(map[string]interface{})
+		if !ok {
+			es = append(es, fmt.Errorf("%q contains an invalid policy: Statement[%d] must be an object", k, i))
+			continue
+		}
+
+		switch effect := statement["Effect"]; effect {
+		case "Allow", "Deny":
+		case nil:
+			es = append(es, fmt.Errorf("%q contains an invalid policy: Statement[%d] is missing Effect", k, i))
+		default:
+			es = append(es, fmt.Errorf("%q contains an invalid policy: Statement[%d] Effect must be one of \"Allow\" or \"Deny\", got %v", k, i, effect))
+		}
+
+		_, hasAction := statement["Action"]
+		_, hasNotAction := statement["NotAction"]
+		if hasAction == hasNotAction {
+			es = append(es, fmt.Errorf("%q contains an invalid policy: Statement[%d] must contain exactly one of Action or NotAction", k, i))
+		}
+
+		for _, key := range []string{"Action", "NotAction"} {
+			if v, ok := statement[key]; ok && !isStringOrStringList(v) {
+				es = append(es, fmt.Errorf("%q contains an invalid policy: Statement[%d] %s must be a string or an array of strings", k, i, key))
+			}
+		}
+
+		for _, key := range []string{"Principal", "NotPrincipal"} {
+			v, ok := statement[key]
+			if !ok {
+				continue
+			}
+
+			switch v := v.(type) {
+			case string:
+				if v != "*" {
+					es = append(es, fmt.Errorf("%q contains an invalid policy: Statement[%d] %s must be \"*\" or an object, got %q", k, i, key, v))
+				}
+			case map[string]interface{}:
+				for principalType, identifiers := range v {
+					if !isStringOrStringList(identifiers) {
+						es = append(es, fmt.Errorf("%q contains an invalid policy: Statement[%d] %s.%s must be a string or an array of strings", k, i, key, principalType))
+					}
+				}
+			default:
+				es = append(es, fmt.Errorf("%q contains an invalid policy: Statement[%d] %s must be \"*\" or an object", k, i, key))
+			}
+		}
+	}
+
+	return
+}
+
+func isStringOrStringList(v interface{}) bool {
+	switch v := v.(type) {
+	case string:
+		return true
+	case []interface{}:
+		for _, e := range v {
+			if _, ok := e.(string); !ok {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
@@ -129,3 +129,103 @@ func TestValidRolePolicyRoleName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidPolicyGrammar(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Name     string
+		Value    string
+		ErrCount int
+	}{
+		{
+			Name:  "valid trust policy",
+			Value: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+		},
+		{
+			Name:  "single statement object",
+			Value: `{"Version":"2012-10-17","Statement":{"Effect":"Deny","Principal":"*","NotAction":["s3:GetObject","s3:PutObject"]}}`,
+		},
+		{
+			Name:  "principal identifier list",
+			Value: `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root"]},"Action":["sts:AssumeRole","sts:TagSession"]}]}`, // lintignore:AWSAT005
+		},
+		{
+			Name:  "invalid JSON is left to the JSON validator",
+			Value: `{"Statement":`,
+		},
+		{
+			Name:     "missing Statement",
+			Value:    `{"Version":"2012-10-17"}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "Statement is a string",
+			Value:    `{"Statement":"Allow"}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "Statement element is not an object",
+			Value:    `{"Statement":["Allow"]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "missing Effect",
+			Value:    `{"Statement":[{"Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "invalid Effect",
+			Value:    `{"Statement":[{"Effect":"allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "missing Action",
+			Value:    `{"Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"}}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "both Action and NotAction",
+			Value:    `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"sts:AssumeRole","NotAction":"sts:TagSession"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "Action is an object",
+			Value:    `{"Statement":[{"Effect":"Allow","Principal":"*","Action":{"sts":"AssumeRole"}}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "Principal is a non-wildcard string",
+			Value:    `{"Statement":[{"Effect":"Allow","Principal":"ec2.amazonaws.com","Action":"sts:AssumeRole"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "Principal is an array",
+			Value:    `{"Statement":[{"Effect":"Allow","Principal":["ec2.amazonaws.com"],"Action":"sts:AssumeRole"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "Principal identifiers are not strings",
+			Value:    `{"Statement":[{"Effect":"Allow","Principal":{"AWS":[123456789012]},"Action":"sts:AssumeRole"}]}`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "multiple errors",
+			Value:    `{"Statement":[{"Effect":"Permit","Principal":"ec2.amazonaws.com"}]}`,
+			ErrCount: 3,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errors := validPolicyGrammar(tc.Value, "assume_role_policy")
+
+			if len(errors) != tc.ErrCount {
+				t.Fatalf("Expected %d policy grammar validation errors, got %d: %v", tc.ErrCount, len(errors), errors)
+			}
+		})
+	}
+}
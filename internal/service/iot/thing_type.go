@@ -182,20 +182,23 @@ func resourceThingTypeDelete(ctx context.Context, d *schema.ResourceData, meta i
 	conn := meta.(*conns.AWSClient).IoTConn(ctx)
 
 	// In order to delete an IoT Thing Type, you must deprecate it first and wait at least 5 minutes.
-	_, err := conn.DeprecateThingTypeWithContext(ctx, &iot.DeprecateThingTypeInput{
-		ThingTypeName: aws.String(d.Id()),
-	})
+	// Deprecating an already deprecated Thing Type would restart the wait.
+	if !d.Get("deprecated").(bool) {
+		_, err := conn.DeprecateThingTypeWithContext(ctx, &iot.DeprecateThingTypeInput{
+			ThingTypeName: aws.String(d.Id()),
+		})
 
-	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
-		return diags
-	}
+		if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+			return diags
+		}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deprecating IoT Thing Type (%s): %s", d.Id(), err)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deprecating IoT Thing Type (%s): %s", d.Id(), err)
+		}
 	}
 
 	log.Printf("[DEBUG] Deleting IoT Thing Type: %s", d.Id())
-	_, err = tfresource.RetryWhenAWSErrMessageContains(ctx, 6*time.Minute, func() (interface{}, error) {
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, 6*time.Minute, func() (interface{}, error) {
 		return conn.DeleteThingTypeWithContext(ctx, &iot.DeleteThingTypeInput{
 			ThingTypeName: aws.String(d.Id()),
 		})
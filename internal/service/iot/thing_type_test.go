@@ -106,6 +106,13 @@ func TestAccIoTThingType_full(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "deprecated", "false"),
 				),
 			},
+			{
+				Config: testAccThingTypeConfig_full(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckThingTypeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deprecated", "true"),
+				),
+			},
 		},
 	})
 }
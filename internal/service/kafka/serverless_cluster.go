@@ -95,6 +95,7 @@ func resourceServerlessCluster() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			// There is no MSK API that modifies a serverless cluster's VPC configuration.
 			"vpc_config": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -141,7 +142,7 @@ func TestAccKafkaServerlessCluster_securityGroup(t *testing.T) {
 		CheckDestroy:             testAccCheckServerlessClusterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccServerlessClusterConfig_securityGroup(rName),
+				Config: testAccServerlessClusterConfig_securityGroup(rName, 1),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckServerlessClusterExists(ctx, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "1"),
@@ -154,6 +155,21 @@ func TestAccKafkaServerlessCluster_securityGroup(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				// MSK Serverless has no API for modifying VPC configuration.
+				Config: testAccServerlessClusterConfig_securityGroup(rName, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServerlessClusterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.security_group_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.subnet_ids.#", "2"),
+				),
+			},
 		},
 	})
 }
@@ -304,9 +320,11 @@ resource "aws_msk_serverless_cluster" "test" {
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2))
 }
 
-func testAccServerlessClusterConfig_securityGroup(rName string) string {
+func testAccServerlessClusterConfig_securityGroup(rName string, securityGroupCount int) string {
 	return acctest.ConfigCompose(testAccServerlessClusterConfig_base(rName), fmt.Sprintf(`
 resource "aws_security_group" "test" {
+  count = %[2]d
+
   vpc_id = aws_vpc.test.id
 
   tags = {
@@ -326,9 +344,9 @@ resource "aws_msk_serverless_cluster" "test" {
   }
 
   vpc_config {
-    security_group_ids = [aws_security_group.test.id]
+    security_group_ids = aws_security_group.test[*].id
     subnet_ids         = aws_subnet.test[*].id
   }
 }
-`, rName))
+`, rName, securityGroupCount))
 }
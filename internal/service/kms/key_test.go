@@ -160,6 +160,44 @@ func TestAccKMSKey_hmacKey(t *testing.T) {
 	})
 }
 
+func TestAccKMSKey_externalKeyStore(t *testing.T) {
+	ctx := acctest.Context(t)
+	customKeyStoreID := acctest.SkipIfEnvVarNotSet(t, "KMS_XKS_CUSTOM_KEY_STORE_ID")
+	xksKeyID := acctest.SkipIfEnvVarNotSet(t, "KMS_XKS_KEY_ID")
+	var key kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyConfig_externalKeyStore(rName, customKeyStoreID, xksKeyID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(ctx, resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_id", customKeyStoreID),
+					resource.TestCheckResourceAttr(resourceName, "xks_key_id", xksKeyID),
+					func(s *terraform.State) error {
+						if got, want := aws.StringValue(key.Origin), kms.OriginTypeExternalKeyStore; got != want {
+							return fmt.Errorf("KMS Key origin = %s, want %s", got, want)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days", "bypass_policy_lockout_safety_check"},
+			},
+		},
+	})
+}
+
 func TestAccKMSKey_Policy_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var key kms.KeyMetadata
@@ -759,6 +797,17 @@ resource "aws_kms_key" "test" {
 `, rName)
 }
 
+func testAccKeyConfig_externalKeyStore(rName, customKeyStoreID, xksKeyID string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  custom_key_store_id     = %[2]q
+  xks_key_id              = %[3]q
+}
+`, rName, customKeyStoreID, xksKeyID)
+}
+
 func testAccKeyConfig_policy(rName string) string {
 	return fmt.Sprintf(`
 
@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -223,7 +225,7 @@ func TestAccLambdaFunctionURL_TwoURLs(t *testing.T) {
 
 func TestAccLambdaFunctionURL_invokeMode(t *testing.T) {
 	ctx := acctest.Context(t)
-	var conf lambda.GetFunctionUrlConfigOutput
+	var conf1, conf2 lambda.GetFunctionUrlConfigOutput
 	resourceName := "aws_lambda_function_url.test"
 	rString := sdkacctest.RandString(8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_basic_%s", rString)
@@ -239,7 +241,7 @@ func TestAccLambdaFunctionURL_invokeMode(t *testing.T) {
 			{
 				Config: testAccFunctionURLConfig_invokeMode(funcName, policyName, roleName, "BUFFERED"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					testAccCheckFunctionURLExists(ctx, resourceName, &conf1),
 					resource.TestCheckResourceAttr(resourceName, "invoke_mode", "BUFFERED"),
 				),
 			},
@@ -250,11 +252,21 @@ func TestAccLambdaFunctionURL_invokeMode(t *testing.T) {
 			},
 			{
 				Config: testAccFunctionURLConfig_invokeMode(funcName, policyName, roleName, "RESPONSE_STREAM"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					testAccCheckFunctionURLExists(ctx, resourceName, &conf2),
+					testAccCheckFunctionURLNotRecreated(&conf1, &conf2),
 					resource.TestCheckResourceAttr(resourceName, "invoke_mode", "RESPONSE_STREAM"),
 				),
 			},
+			{
+				Config:   testAccFunctionURLConfig_invokeMode(funcName, policyName, roleName, "RESPONSE_STREAM"),
+				PlanOnly: true,
+			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
@@ -263,7 +275,8 @@ func TestAccLambdaFunctionURL_invokeMode(t *testing.T) {
 			{
 				Config: testAccFunctionURLConfig_basic(funcName, policyName, roleName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					testAccCheckFunctionURLExists(ctx, resourceName, &conf2),
+					testAccCheckFunctionURLNotRecreated(&conf1, &conf2),
 					resource.TestCheckResourceAttr(resourceName, "invoke_mode", "BUFFERED"),
 				),
 			},
@@ -276,6 +289,16 @@ func TestAccLambdaFunctionURL_invokeMode(t *testing.T) {
 	})
 }
 
+func testAccCheckFunctionURLNotRecreated(before, after *lambda.GetFunctionUrlConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.FunctionUrl), aws.StringValue(after.FunctionUrl); before != after {
+			return fmt.Errorf("Expected Lambda Function URL not to change, but got before: %s, after: %s", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckFunctionURLExists(ctx context.Context, n string, v *lambda.GetFunctionUrlConfigOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
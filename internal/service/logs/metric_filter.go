@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_cloudwatch_log_metric_filter")
@@ -57,9 +58,10 @@ func resourceMetricFilter() *schema.Resource {
 							ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 						},
 						"dimensions": {
-							Type:     schema.TypeMap,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Type:             schema.TypeMap,
+							Optional:         true,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							ValidateDiagFunc: verify.MapLenBetween(0, 3),
 						},
 						"name": {
 							Type:         schema.TypeString,
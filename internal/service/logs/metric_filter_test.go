@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -184,6 +185,24 @@ func TestAccLogsMetricFilter_update(t *testing.T) {
 	})
 }
 
+func TestAccLogsMetricFilter_tooManyDimensions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchLogsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMetricFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccMetricFilterConfig_tooManyDimensions(rName),
+				ExpectError: regexache.MustCompile(`Map must contain at least 0 elements and at most 3 elements`),
+			},
+		},
+	})
+}
+
 func testAccMetricFilterImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -352,3 +371,33 @@ EOS
 }
 `, rName)
 }
+
+func testAccMetricFilterConfig_tooManyDimensions(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_metric_filter" "test" {
+  name    = %[1]q
+  pattern = <<EOS
+    { $.d1 = "OK" }
+EOS
+
+  log_group_name = aws_cloudwatch_log_group.test.name
+
+  metric_transformation {
+    name      = "metric2"
+    namespace = "ns2"
+    value     = "10"
+
+    dimensions = {
+      d1 = "$.d1"
+      d2 = "$.d2"
+      d3 = "$.d3"
+      d4 = "$.d4"
+    }
+  }
+}
+`, rName)
+}
@@ -46,6 +46,20 @@ func ResourceClassificationJob() *schema.Resource {
 				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"managed_data_identifier_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"managed_data_identifier_selector": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(macie2.ManagedDataIdentifierSelector_Values(), false),
+			},
 			"schedule_frequency": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -574,6 +588,27 @@ func resourceClassificationJobCustomizeDiff(_ context.Context, diff *schema.Reso
 			}
 		}
 	}
+
+	// managed_data_identifier_ids is required for the EXCLUDE and INCLUDE selectors and not allowed otherwise.
+	if v := diff.GetRawConfig().GetAttr("managed_data_identifier_selector"); v.IsKnown() && !v.IsNull() {
+		selector := v.AsString()
+
+		if v := diff.GetRawConfig().GetAttr("managed_data_identifier_ids"); v.IsKnown() {
+			hasIDs := !v.IsNull() && v.LengthInt() > 0
+
+			switch selector {
+			case macie2.ManagedDataIdentifierSelectorExclude, macie2.ManagedDataIdentifierSelectorInclude:
+				if !hasIDs {
+					return fmt.Errorf("managed_data_identifier_ids must be specified when managed_data_identifier_selector is %q", selector)
+				}
+			default:
+				if hasIDs {
+					return fmt.Errorf("managed_data_identifier_ids cannot be specified when managed_data_identifier_selector is %q", selector)
+				}
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -593,6 +628,12 @@ func resourceClassificationJobCreate(ctx context.Context, d *schema.ResourceData
 	if v, ok := d.GetOk("custom_data_identifier_ids"); ok {
 		input.CustomDataIdentifierIds = flex.ExpandStringList(v.([]interface{}))
 	}
+	if v, ok := d.GetOk("managed_data_identifier_ids"); ok {
+		input.ManagedDataIdentifierIds = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("managed_data_identifier_selector"); ok {
+		input.ManagedDataIdentifierSelector = aws.String(v.(string))
+	}
 	if v, ok := d.GetOk("schedule_frequency"); ok {
 		input.ScheduleFrequency = expandScheduleFrequency(v.([]interface{}))
 	}
@@ -660,6 +701,10 @@ func resourceClassificationJobRead(ctx context.Context, d *schema.ResourceData,
 	if err = d.Set("custom_data_identifier_ids", flex.FlattenStringList(resp.CustomDataIdentifierIds)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting `%s` for Macie ClassificationJob (%s): %s", "custom_data_identifier_ids", d.Id(), err)
 	}
+	if err = d.Set("managed_data_identifier_ids", flex.FlattenStringList(resp.ManagedDataIdentifierIds)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting `%s` for Macie ClassificationJob (%s): %s", "managed_data_identifier_ids", d.Id(), err)
+	}
+	d.Set("managed_data_identifier_selector", resp.ManagedDataIdentifierSelector)
 	if err = d.Set("schedule_frequency", flattenScheduleFrequency(resp.ScheduleFrequency)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting `%s` for Macie ClassificationJob (%s): %s", "schedule_frequency", d.Id(), err)
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -390,6 +391,45 @@ func testAccClassificationJob_BucketCriteria(t *testing.T) {
 	})
 }
 
+func testAccClassificationJob_ManagedDataIdentifierSelector(t *testing.T) {
+	ctx := acctest.Context(t)
+	var macie2Output macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClassificationJobDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClassificationJobConfig_managedDataIdentifierSelector(bucketName, macie2.ManagedDataIdentifierSelectorExclude, false),
+				ExpectError: regexache.MustCompile(`managed_data_identifier_ids must be specified`),
+			},
+			{
+				Config:      testAccClassificationJobConfig_managedDataIdentifierSelector(bucketName, macie2.ManagedDataIdentifierSelectorAll, true),
+				ExpectError: regexache.MustCompile(`managed_data_identifier_ids cannot be specified`),
+			},
+			{
+				Config: testAccClassificationJobConfig_managedDataIdentifierSelector(bucketName, macie2.ManagedDataIdentifierSelectorExclude, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationJobExists(ctx, resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "managed_data_identifier_selector", macie2.ManagedDataIdentifierSelectorExclude),
+					resource.TestCheckResourceAttr(resourceName, "managed_data_identifier_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "managed_data_identifier_ids.0", "CREDIT_CARD_NUMBER"),
+					resource.TestCheckResourceAttr(resourceName, "managed_data_identifier_ids.1", "CREDIT_CARD_SECURITY_CODE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckClassificationJobExists(ctx context.Context, resourceName string, macie2Session *macie2.DescribeClassificationJobOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -764,3 +804,35 @@ resource "aws_macie2_classification_job" "test" {
 }
 `, jobStatus, description)
 }
+
+func testAccClassificationJobConfig_managedDataIdentifierSelector(bucketName, selector string, withIDs bool) string {
+	var ids string
+	if withIDs {
+		ids = `managed_data_identifier_ids = ["CREDIT_CARD_NUMBER", "CREDIT_CARD_SECURITY_CODE"]`
+	}
+
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_macie2_classification_job" "test" {
+  depends_on = [aws_macie2_account.test]
+  job_type   = "ONE_TIME"
+
+  managed_data_identifier_selector = %[2]q
+  %[3]s
+
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+}
+`, bucketName, selector, ids)
+}
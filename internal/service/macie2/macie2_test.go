@@ -24,14 +24,15 @@ func TestAccMacie2_serial(t *testing.T) {
 			"basic": testAccClassificationExportConfiguration_basic,
 		},
 		"ClassificationJob": {
-			"basic":           testAccClassificationJob_basic,
-			"name_generated":  testAccClassificationJob_Name_Generated,
-			"name_prefix":     testAccClassificationJob_NamePrefix,
-			"disappears":      testAccClassificationJob_disappears,
-			"status":          testAccClassificationJob_Status,
-			"complete":        testAccClassificationJob_complete,
-			"tags":            testAccClassificationJob_WithTags,
-			"bucket_criteria": testAccClassificationJob_BucketCriteria,
+			"basic":                            testAccClassificationJob_basic,
+			"name_generated":                   testAccClassificationJob_Name_Generated,
+			"name_prefix":                      testAccClassificationJob_NamePrefix,
+			"disappears":                       testAccClassificationJob_disappears,
+			"status":                           testAccClassificationJob_Status,
+			"complete":                         testAccClassificationJob_complete,
+			"tags":                             testAccClassificationJob_WithTags,
+			"bucket_criteria":                  testAccClassificationJob_BucketCriteria,
+			"managed_data_identifier_selector": testAccClassificationJob_ManagedDataIdentifierSelector,
 		},
 		"CustomDataIdentifier": {
 			"basic":              testAccCustomDataIdentifier_basic,
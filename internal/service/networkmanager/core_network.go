@@ -6,6 +6,7 @@ package networkmanager
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -268,6 +269,7 @@ func resourceCoreNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 
 	conn := meta.(*conns.AWSClient).NetworkManagerConn(ctx)
+	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutUpdate))
 
 	if d.HasChange("description") {
 		_, err := conn.UpdateCoreNetworkWithContext(ctx, &networkmanager.UpdateCoreNetworkInput{
@@ -279,34 +281,40 @@ func resourceCoreNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta
 			return sdkdiag.AppendErrorf(diags, "updating Network Manager Core Network (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitCoreNetworkUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitCoreNetworkUpdated(ctx, conn, d.Id(), deadline.Remaining()); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Network Manager Core Network (%s) update: %s", d.Id(), err)
 		}
 	}
 
 	if d.HasChange("create_base_policy") {
 		if _, ok := d.GetOk("create_base_policy"); ok {
-			// if user supplies a region or multiple regions use it in the base policy, otherwise use current region
-			regions := []interface{}{meta.(*conns.AWSClient).Region}
-			if v, ok := d.GetOk("base_policy_region"); ok {
-				regions = []interface{}{v.(string)}
-			} else if v, ok := d.GetOk("base_policy_regions"); ok && v.(*schema.Set).Len() > 0 {
-				regions = v.(*schema.Set).List()
-			}
-
-			policyDocumentTarget, err := buildCoreNetworkBasePolicyDocument(regions)
-
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "Formatting Core Network Base Policy: %s", err)
+			var policyDocumentTarget string
+			if v, ok := d.GetOk("base_policy_document"); ok {
+				policyDocumentTarget = v.(string)
+			} else {
+				// if user supplies a region or multiple regions use it in the base policy, otherwise use current region
+				regions := []interface{}{meta.(*conns.AWSClient).Region}
+				if v, ok := d.GetOk("base_policy_region"); ok {
+					regions = []interface{}{v.(string)}
+				} else if v, ok := d.GetOk("base_policy_regions"); ok && v.(*schema.Set).Len() > 0 {
+					regions = v.(*schema.Set).List()
+				}
+
+				var err error
+				policyDocumentTarget, err = buildCoreNetworkBasePolicyDocument(regions)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "Formatting Core Network Base Policy: %s", err)
+				}
 			}
 
-			err = PutAndExecuteCoreNetworkPolicy(ctx, conn, d.Id(), policyDocumentTarget)
+			err := PutAndExecuteCoreNetworkPolicy(ctx, conn, d.Id(), policyDocumentTarget, deadline.Remaining())
 
 			if err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
 
-			if _, err := waitCoreNetworkUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if _, err := waitCoreNetworkUpdated(ctx, conn, d.Id(), deadline.Remaining()); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for Network Manager Core Network (%s) update: %s", d.Id(), err)
 			}
 		}
@@ -542,37 +550,55 @@ func flattenCoreNetworkSegments(apiObjects []*networkmanager.CoreNetworkSegment)
 	return tfList
 }
 
-func PutAndExecuteCoreNetworkPolicy(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkId, policyDocument string) error {
+func PutAndExecuteCoreNetworkPolicy(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkId, policyDocument string, timeout time.Duration) error {
 	v, err := protocol.DecodeJSONValue(policyDocument, protocol.NoEscape)
 
 	if err != nil {
 		return fmt.Errorf("decoding Network Manager Core Network (%s) policy document: %s", coreNetworkId, err)
 	}
 
-	output, err := conn.PutCoreNetworkPolicyWithContext(ctx, &networkmanager.PutCoreNetworkPolicyInput{
-		ClientToken:    aws.String(id.UniqueId()),
-		CoreNetworkId:  aws.String(coreNetworkId),
-		PolicyDocument: v,
-	})
+	// Putting, executing and waiting for the policy version share a single timeout.
+	deadline := tfresource.NewDeadline(timeout)
+
+	// A new policy version is rejected while a previous change set is still executing.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, deadline.Remaining(), func() (interface{}, error) {
+		return conn.PutCoreNetworkPolicyWithContext(ctx, &networkmanager.PutCoreNetworkPolicyInput{
+			ClientToken:    aws.String(id.UniqueId()),
+			CoreNetworkId:  aws.String(coreNetworkId),
+			PolicyDocument: v,
+		})
+	}, networkmanager.ErrCodeConflictException)
 
 	if err != nil {
 		return fmt.Errorf("putting Network Manager Core Network (%s) policy: %s", coreNetworkId, err)
 	}
 
-	policyVersionID := aws.Int64Value(output.CoreNetworkPolicy.PolicyVersionId)
+	policyVersionID := aws.Int64Value(outputRaw.(*networkmanager.PutCoreNetworkPolicyOutput).CoreNetworkPolicy.PolicyVersionId)
+
+	createTimeout := waitCoreNetworkPolicyCreatedTimeInMinutes * time.Minute
+	if v := deadline.Remaining(); v < createTimeout {
+		createTimeout = v
+	}
 
-	if _, err := waitCoreNetworkPolicyCreated(ctx, conn, coreNetworkId, policyVersionID, waitCoreNetworkPolicyCreatedTimeInMinutes*time.Minute); err != nil {
+	if _, err := waitCoreNetworkPolicyCreated(ctx, conn, coreNetworkId, policyVersionID, createTimeout); err != nil {
 		return fmt.Errorf("waiting for Network Manager Core Network Policy from Core Network (%s) create: %s", coreNetworkId, err)
 	}
 
-	_, err = conn.ExecuteCoreNetworkChangeSetWithContext(ctx, &networkmanager.ExecuteCoreNetworkChangeSetInput{
-		CoreNetworkId:   aws.String(coreNetworkId),
-		PolicyVersionId: aws.Int64(policyVersionID),
-	})
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, deadline.Remaining(), func() (interface{}, error) {
+		return conn.ExecuteCoreNetworkChangeSetWithContext(ctx, &networkmanager.ExecuteCoreNetworkChangeSetInput{
+			CoreNetworkId:   aws.String(coreNetworkId),
+			PolicyVersionId: aws.Int64(policyVersionID),
+		})
+	}, networkmanager.ErrCodeConflictException)
+
 	if err != nil {
 		return fmt.Errorf("executing Network Manager Core Network (%s) change set (%d): %s", coreNetworkId, policyVersionID, err)
 	}
 
+	if _, err := waitCoreNetworkPolicyExecuted(ctx, conn, coreNetworkId, policyVersionID, deadline.Remaining()); err != nil {
+		return fmt.Errorf("waiting for Network Manager Core Network (%s) change set (%d) execute: %s", coreNetworkId, policyVersionID, err)
+	}
+
 	return nil
 }
 
@@ -603,18 +629,38 @@ func waitCoreNetworkPolicyCreated(ctx context.Context, conn *networkmanager.Netw
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*networkmanager.CoreNetworkPolicy); ok {
+		if state := aws.StringValue(output.ChangeSetState); state == networkmanager.ChangeSetStateFailedGeneration {
+			tfresource.SetLastError(err, coreNetworkPolicyError(output.PolicyErrors))
+		}
+
 		return output, err
 	}
 
+	return nil, err
+}
+
+func waitCoreNetworkPolicyExecuted(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkId string, policyVersionId int64, timeout time.Duration) (*networkmanager.CoreNetworkPolicy, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{networkmanager.ChangeSetStateReadyToExecute, networkmanager.ChangeSetStateExecuting},
+		Target:     []string{networkmanager.ChangeSetStateExecutionSucceeded},
+		Timeout:    timeout,
+		Refresh:    statusCoreNetworkPolicyState(ctx, conn, coreNetworkId, policyVersionId),
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
 	if output, ok := outputRaw.(*networkmanager.CoreNetworkPolicy); ok {
-		if state, errors := aws.StringValue(output.ChangeSetState), output.PolicyErrors; state == networkmanager.ChangeSetStateFailedGeneration && len(errors) > 0 {
-			var errs *multierror.Error
+		// A failed or superseded execution leaves the change set OUT_OF_DATE or returns it to an earlier state.
+		if state := aws.StringValue(output.ChangeSetState); state != networkmanager.ChangeSetStateExecutionSucceeded {
+			lastErr := coreNetworkPolicyError(output.PolicyErrors)
 
-			for _, err := range errors {
-				errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(err.ErrorCode), aws.StringValue(err.Message)))
+			if lastErr == nil && state == networkmanager.ChangeSetStateOutOfDate {
+				lastErr = errors.New("policy version was superseded before its change set finished executing")
 			}
 
-			tfresource.SetLastError(err, errs.ErrorOrNil())
+			tfresource.SetLastError(err, lastErr)
 		}
 
 		return output, err
@@ -623,6 +669,20 @@ func waitCoreNetworkPolicyCreated(ctx context.Context, conn *networkmanager.Netw
 	return nil, err
 }
 
+func coreNetworkPolicyError(apiObjects []*networkmanager.CoreNetworkPolicyError) error {
+	var errs *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.Message)))
+	}
+
+	return errs.ErrorOrNil()
+}
+
 // buildCoreNetworkBasePolicyDocument returns a base policy document
 func buildCoreNetworkBasePolicyDocument(regions []interface{}) (string, error) {
 	edgeLocations := make([]*CoreNetworkEdgeLocation, len(regions))
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -137,6 +138,83 @@ func TestAccNetworkManagerCoreNetworkPolicyAttachment_vpcAttachmentMultiRegion(t
 	})
 }
 
+func TestAccNetworkManagerCoreNetworkPolicyAttachment_basePolicyDocument(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_networkmanager_core_network_policy_attachment.test"
+	coreNetworkResourceName := "aws_networkmanager_core_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCoreNetworkPolicyAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreNetworkConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreNetworkExists(ctx, coreNetworkResourceName),
+				),
+			},
+			{
+				// Enabling create_base_policy on update applies base_policy_document.
+				Config: testAccCoreNetworkPolicyAttachmentConfig_baseBasePolicyDocument(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreNetworkExists(ctx, coreNetworkResourceName),
+					testAccCheckCoreNetworkLatestPolicyExecuted(ctx, coreNetworkResourceName),
+					resource.TestCheckResourceAttr(coreNetworkResourceName, "create_base_policy", "true"),
+					resource.TestCheckTypeSetElemNestedAttrs(coreNetworkResourceName, "edges.*", map[string]string{
+						"asn":           "65500",
+						"edge_location": acctest.Region(),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(coreNetworkResourceName, "segments.*", map[string]string{
+						"name": "segment",
+					}),
+				),
+			},
+			{
+				Config: testAccCoreNetworkPolicyAttachmentConfig_basePolicyDocument("segmentValue1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreNetworkPolicyAttachmentExists(ctx, resourceName),
+					testAccCheckCoreNetworkLatestPolicyExecuted(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", networkmanager.CoreNetworkStateAvailable),
+				),
+			},
+			{
+				Config: testAccCoreNetworkPolicyAttachmentConfig_basePolicyDocument("segmentValue2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreNetworkPolicyAttachmentExists(ctx, resourceName),
+					testAccCheckCoreNetworkLatestPolicyExecuted(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", networkmanager.CoreNetworkStateAvailable),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkManagerCoreNetworkPolicyAttachment_basePolicyDocumentConcurrent(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_networkmanager_core_network_policy_attachment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCoreNetworkPolicyAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The core network is created with its base policy and the attachment's policy is put
+				// in the same apply, while the base policy's change set may still be executing.
+				Config: testAccCoreNetworkPolicyAttachmentConfig_basePolicyDocument("segmentValue1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCoreNetworkPolicyAttachmentExists(ctx, resourceName),
+					testAccCheckCoreNetworkLatestPolicyExecuted(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", networkmanager.CoreNetworkStateAvailable),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkManagerCoreNetworkPolicyAttachment_expectPolicyErrorInvalidASNRange(t *testing.T) {
 	ctx := acctest.Context(t)
 
@@ -180,6 +258,30 @@ func testAccCheckCoreNetworkPolicyAttachmentExists(ctx context.Context, n string
 	}
 }
 
+func testAccCheckCoreNetworkLatestPolicyExecuted(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkManagerConn(ctx)
+
+		const latestPolicyVersionId = -1
+		output, err := tfnetworkmanager.FindCoreNetworkPolicyByTwoPartKey(ctx, conn, rs.Primary.ID, latestPolicyVersionId)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.StringValue(output.ChangeSetState), networkmanager.ChangeSetStateExecutionSucceeded; got != want {
+			return fmt.Errorf("Network Manager Core Network (%s) policy version %d change set state = %s, want %s", rs.Primary.ID, aws.Int64Value(output.PolicyVersionId), got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCoreNetworkPolicyAttachmentConfig_basic(segmentValue string) string {
 	return fmt.Sprintf(`
 resource "aws_networkmanager_global_network" "test" {}
@@ -438,3 +540,54 @@ resource "aws_networkmanager_core_network_policy_attachment" "test" {
 }
 `, acctest.Region())
 }
+
+func testAccCoreNetworkPolicyAttachmentConfig_baseBasePolicyDocument() string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {}
+
+data "aws_networkmanager_core_network_policy_document" "base" {
+  core_network_configuration {
+    asn_ranges = ["65022-65534"]
+
+    edge_locations {
+      location = %[1]q
+      asn      = "65500"
+    }
+  }
+
+  segments {
+    name = "segment"
+  }
+}
+
+resource "aws_networkmanager_core_network" "test" {
+  global_network_id    = aws_networkmanager_global_network.test.id
+  create_base_policy   = true
+  base_policy_document = data.aws_networkmanager_core_network_policy_document.base.json
+}
+`, acctest.Region())
+}
+
+func testAccCoreNetworkPolicyAttachmentConfig_basePolicyDocument(segmentValue string) string {
+	return acctest.ConfigCompose(testAccCoreNetworkPolicyAttachmentConfig_baseBasePolicyDocument(), fmt.Sprintf(`
+data "aws_networkmanager_core_network_policy_document" "test" {
+  core_network_configuration {
+    asn_ranges = ["65022-65534"]
+
+    edge_locations {
+      location = %[2]q
+      asn      = "65500"
+    }
+  }
+
+  segments {
+    name = %[1]q
+  }
+}
+
+resource "aws_networkmanager_core_network_policy_attachment" "test" {
+  core_network_id = aws_networkmanager_core_network.test.id
+  policy_document = data.aws_networkmanager_core_network_policy_document.test.json
+}
+`, segmentValue, acctest.Region()))
+}
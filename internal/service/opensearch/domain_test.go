@@ -781,7 +781,7 @@ func TestAccOpenSearchDomain_autoTuneOptions(t *testing.T) {
 		CheckDestroy:             testAccCheckDomainDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDomainConfig_autoTuneOptions(rName, autoTuneStartAtTime),
+				Config: testAccDomainConfig_autoTuneOptions(rName, autoTuneStartAtTime, "cron(0 0 ? * 1 *)"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDomainExists(ctx, resourceName, &domain),
 					resource.TestCheckResourceAttr(resourceName, "engine_version", "Elasticsearch_6.7"),
@@ -803,6 +803,16 @@ func TestAccOpenSearchDomain_autoTuneOptions(t *testing.T) {
 				ImportStateId:     rName,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccDomainConfig_autoTuneOptions(rName, autoTuneStartAtTime, "cron(0 12 ? * 6 *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(ctx, resourceName, &domain),
+					resource.TestCheckResourceAttr(resourceName, "auto_tune_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_tune_options.0.maintenance_schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_tune_options.0.maintenance_schedule.0.start_at", autoTuneStartAtTime),
+					resource.TestCheckResourceAttr(resourceName, "auto_tune_options.0.maintenance_schedule.0.cron_expression_for_recurrence", "cron(0 12 ? * 6 *)"),
+				),
+			},
 		},
 	})
 }
@@ -2182,7 +2192,7 @@ resource "aws_opensearch_domain" "test" {
 `, rName)
 }
 
-func testAccDomainConfig_autoTuneOptions(rName, autoTuneStartAtTime string) string {
+func testAccDomainConfig_autoTuneOptions(rName, autoTuneStartAtTime, cronExpression string) string {
 	return fmt.Sprintf(`
 resource "aws_opensearch_domain" "test" {
   domain_name    = %[1]q
@@ -2202,14 +2212,14 @@ resource "aws_opensearch_domain" "test" {
         value = "2"
         unit  = "HOURS"
       }
-      cron_expression_for_recurrence = "cron(0 0 ? * 1 *)"
+      cron_expression_for_recurrence = %[3]q
     }
 
     rollback_on_disable = "NO_ROLLBACK"
 
   }
 }
-`, rName, autoTuneStartAtTime)
+`, rName, autoTuneStartAtTime, cronExpression)
 }
 
 func testAccDomainConfig_disabledEBSNullVolume(rName string) string {
@@ -11,6 +11,8 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -306,6 +308,64 @@ func (r *resourceVpcEndpoint) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+func (r *resourceVpcEndpoint) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan resourceVpcEndpointData
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The VPC can only be checked once its ID is known, e.g. when it already exists.
+	if plan.VpcId.IsUnknown() || plan.VpcId.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state resourceVpcEndpointData
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() || state.VpcId.Equal(plan.VpcId) {
+			return
+		}
+	}
+
+	if r.Meta() == nil {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+	vpcID := plan.VpcId.ValueString()
+
+	out, err := conn.DescribeVpcAttribute(ctx, &ec2.DescribeVpcAttributeInput{
+		Attribute: ec2types.VpcAttributeNameEnableDnsHostnames,
+		VpcId:     aws.String(vpcID),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("vpc_id"),
+			fmt.Sprintf("Unable to check EC2 VPC (%s) DNS hostnames", vpcID),
+			err.Error(),
+		)
+		return
+	}
+
+	if out.EnableDnsHostnames != nil && !aws.ToBool(out.EnableDnsHostnames.Value) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("vpc_id"),
+			"Invalid VPC",
+			fmt.Sprintf("EC2 VPC (%s) must have DNS hostnames enabled (enable_dns_hostnames) to create an OpenSearch Serverless VPC endpoint.", vpcID),
+		)
+	}
+}
+
 func (r *resourceVpcEndpoint) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
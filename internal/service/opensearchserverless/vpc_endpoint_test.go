@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
 	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
@@ -159,6 +160,39 @@ func TestAccOpenSearchServerlessVPCEndpoint_update(t *testing.T) {
 	})
 }
 
+func TestAccOpenSearchServerlessVPCEndpoint_networkPolicy(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	ctx := acctest.Context(t)
+	var vpcendpoint types.VpcEndpointDetail
+	var securitypolicy types.SecurityPolicyDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opensearchserverless_vpc_endpoint.test"
+	securityPolicyResourceName := "aws_opensearchserverless_security_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+			testAccPreCheckVPCEndpoint(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointConfig_networkPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCEndpointExists(ctx, resourceName, &vpcendpoint),
+					testAccCheckSecurityPolicyExists(ctx, securityPolicyResourceName, &securitypolicy),
+					resource.TestMatchResourceAttr(securityPolicyResourceName, "policy", regexache.MustCompile(`vpce-`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccOpenSearchServerlessVPCEndpoint_disappears(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -190,6 +224,32 @@ func TestAccOpenSearchServerlessVPCEndpoint_disappears(t *testing.T) {
 	})
 }
 
+func TestAccOpenSearchServerlessVPCEndpoint_dnsHostnamesDisabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+			testAccPreCheckVPCEndpoint(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			// Create the VPC first so that vpc_id is known when the endpoint is planned.
+			{
+				Config: testAccVPCEndpointConfig_networkingBaseDNSHostnames(rName, 1, false),
+			},
+			{
+				Config:      testAccVPCEndpointConfig_dnsHostnamesDisabled(rName),
+				ExpectError: regexache.MustCompile(`must have DNS hostnames enabled`),
+			},
+		},
+	})
+}
+
 func testAccCheckVPCEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessClient(ctx)
@@ -266,12 +326,16 @@ func testAccCheckVPCEndpointNotRecreated(before, after *types.VpcEndpointDetail)
 }
 
 func testAccVPCEndpointConfig_networkingBase(rName string, subnetCount int) string {
+	return testAccVPCEndpointConfig_networkingBaseDNSHostnames(rName, subnetCount, true)
+}
+
+func testAccVPCEndpointConfig_networkingBaseDNSHostnames(rName string, subnetCount int, enableDNSHostnames bool) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAvailableAZsNoOptInDefaultExclude(),
 		fmt.Sprintf(`
 resource "aws_vpc" "test" {
   cidr_block           = "10.0.0.0/16"
-  enable_dns_hostnames = true
+  enable_dns_hostnames = %[3]t
 
   tags = {
     Name = %[1]q
@@ -289,7 +353,7 @@ resource "aws_subnet" "test" {
     Name = %[1]q
   }
 }
-`, rName, subnetCount),
+`, rName, subnetCount, enableDNSHostnames),
 	)
 }
 
@@ -321,6 +385,18 @@ resource "aws_opensearchserverless_vpc_endpoint" "test" {
 `, rName))
 }
 
+func testAccVPCEndpointConfig_dnsHostnamesDisabled(rName string) string {
+	return acctest.ConfigCompose(
+		testAccVPCEndpointConfig_networkingBaseDNSHostnames(rName, 1, false),
+		fmt.Sprintf(`
+resource "aws_opensearchserverless_vpc_endpoint" "test" {
+  name       = %[1]q
+  subnet_ids = [aws_subnet.test[0].id]
+  vpc_id     = aws_vpc.test.id
+}
+`, rName))
+}
+
 func testAccVPCEndpointConfig_multiple_subnets(rName string) string {
 	return acctest.ConfigCompose(
 		testAccVPCEndpointConfig_networkingBase(rName, 2),
@@ -362,3 +438,33 @@ resource "aws_opensearchserverless_vpc_endpoint" "test" {
 }
 `, rName))
 }
+
+func testAccVPCEndpointConfig_networkPolicy(rName string) string {
+	return acctest.ConfigCompose(
+		testAccVPCEndpointConfig_networkingBase(rName, 1),
+		fmt.Sprintf(`
+resource "aws_opensearchserverless_vpc_endpoint" "test" {
+  name       = %[1]q
+  subnet_ids = [aws_subnet.test[0].id]
+  vpc_id     = aws_vpc.test.id
+}
+
+resource "aws_opensearchserverless_security_policy" "test" {
+  name = %[1]q
+  type = "network"
+
+  policy = jsonencode([
+    {
+      Rules = [
+        {
+          ResourceType = "collection"
+          Resource     = ["collection/%[1]s"]
+        },
+      ]
+      AllowFromPublic = false
+      SourceVPCEs     = [aws_opensearchserverless_vpc_endpoint.test.id]
+    }
+  ])
+}
+`, rName))
+}
@@ -0,0 +1,2059 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpoint
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_pinpoint_journey", name="Journey")
+// @Tags(identifierAttribute="arn")
+func ResourceJourney() *schema.Resource {
+	nextActivitySchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		}
+	}
+
+	waitTimeSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"wait_for": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"wait_until": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+				},
+			},
+		}
+	}
+
+	eventDimensionsSchema := func(required bool) *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: !required,
+			Required: required,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"attribute": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"attribute_type": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice(pinpoint.AttributeType_Values(), false),
+								},
+								"name": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"values": {
+									Type:     schema.TypeSet,
+									Required: true,
+									Elem:     &schema.Schema{Type: schema.TypeString},
+								},
+							},
+						},
+					},
+					"event_type": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"dimension_type": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice(pinpoint.DimensionType_Values(), false),
+								},
+								"values": {
+									Type:     schema.TypeSet,
+									Required: true,
+									Elem:     &schema.Schema{Type: schema.TypeString},
+								},
+							},
+						},
+					},
+					"metric": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"comparison_operator": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"name": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"value": {
+									Type:     schema.TypeFloat,
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	simpleConditionSchema := func() *schema.Resource {
+		return &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"event_condition": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"dimensions": eventDimensionsSchema(false),
+							"message_activity": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"segment_condition": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"segment_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	messageActivitySchema := func(messageConfig map[string]*schema.Schema) *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"message_config": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: messageConfig,
+						},
+					},
+					"next_activity": nextActivitySchema(),
+					"template_name": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"template_version": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJourneyCreate,
+		ReadWithoutTimeout:   resourceJourneyRead,
+		UpdateWithoutTimeout: resourceJourneyUpdate,
+		DeleteWithoutTimeout: resourceJourneyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"activity": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"conditional_split": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"conditions": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     simpleConditionSchema(),
+												},
+												"operator": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(pinpoint.Operator_Values(), false),
+												},
+											},
+										},
+									},
+									"evaluation_wait_time": waitTimeSchema(),
+									"false_activity":       nextActivitySchema(),
+									"true_activity":        nextActivitySchema(),
+								},
+							},
+						},
+						"contact_center": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"next_activity": nextActivitySchema(),
+								},
+							},
+						},
+						"custom": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delivery_uri": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"endpoint_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(pinpoint.EndpointTypesElement_Values(), false),
+										},
+									},
+									"message_config": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"data": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"next_activity": nextActivitySchema(),
+									"template_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"template_version": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"email": messageActivitySchema(map[string]*schema.Schema{
+							"from_address": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						}),
+						"holdout": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"next_activity": nextActivitySchema(),
+									"percentage": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+								},
+							},
+						},
+						"multi_condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"branch": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"condition": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem:     simpleConditionSchema(),
+												},
+												"next_activity": nextActivitySchema(),
+											},
+										},
+									},
+									"default_activity":     nextActivitySchema(),
+									"evaluation_wait_time": waitTimeSchema(),
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"push": messageActivitySchema(map[string]*schema.Schema{
+							"time_to_live": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						}),
+						"random_split": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"branch": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"next_activity": nextActivitySchema(),
+												"percentage": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 100),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"sms": messageActivitySchema(map[string]*schema.Schema{
+							"entity_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"message_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice(pinpoint.MessageType_Values(), false),
+							},
+							"origination_number": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"sender_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"template_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						}),
+						"wait": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"next_activity": nextActivitySchema(),
+									"wait_time":     waitTimeSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"journey_channel_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connect_campaign_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"connect_campaign_execution_role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"journey_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"limits": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily_cap": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"endpoint_reentry_cap": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"endpoint_reentry_interval": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"messages_per_second": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"timeframe_cap": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cap": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"days": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"total_cap": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"local_time": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"quiet_time": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"refresh_frequency": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"refresh_on_segment_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"schedule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						"start_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						"timezone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"sending_schedule": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"start_activity": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"start_condition": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"event_start_condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"event_filter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dimensions": eventDimensionsSchema(true),
+												"filter_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(pinpoint.FilterType_Values(), false),
+												},
+											},
+										},
+									},
+									"segment_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"segment_start_condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"segment_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(journeyState_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"timezone_estimation_methods": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(pinpoint.TimezoneEstimationMethodsElement_Values(), false),
+				},
+			},
+			"wait_for_quiet_time": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	journeyResourceIDPartCount = 2
+)
+
+// journeyState_Values returns the journey states that can be configured.
+// COMPLETED and CLOSED are set by Amazon Pinpoint only.
+func journeyState_Values() []string {
+	return []string{
+		pinpoint.StateDraft,
+		pinpoint.StateActive,
+		pinpoint.StatePaused,
+		pinpoint.StateCancelled,
+	}
+}
+
+func resourceJourneyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointConn(ctx)
+
+	applicationID := d.Get("application_id").(string)
+	name := d.Get("name").(string)
+	request := expandWriteJourneyRequest(d)
+
+	if v, ok := d.GetOk("state"); ok {
+		request.State = aws.String(v.(string))
+	}
+
+	input := &pinpoint.CreateJourneyInput{
+		ApplicationId:       aws.String(applicationID),
+		WriteJourneyRequest: request,
+	}
+
+	output, err := conn.CreateJourneyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Pinpoint Journey (%s): %s", name, err)
+	}
+
+	journeyID := aws.StringValue(output.JourneyResponse.Id)
+	id := errs.Must(flex.FlattenResourceId([]string{applicationID, journeyID}, journeyResourceIDPartCount, false))
+
+	d.SetId(id)
+
+	if err := updateTags(ctx, conn, journeyARN(meta.(*conns.AWSClient), applicationID, journeyID), nil, getTagsIn(ctx)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting Pinpoint Journey (%s) tags: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceJourneyRead(ctx, d, meta)...)
+}
+
+func resourceJourneyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), journeyResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	applicationID, journeyID := parts[0], parts[1]
+	journey, err := FindJourneyByTwoPartKey(ctx, conn, applicationID, journeyID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Pinpoint Journey (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Pinpoint Journey (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("activity", flattenJourneyActivities(journey.Activities)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting activity: %s", err)
+	}
+	d.Set("application_id", journey.ApplicationId)
+	d.Set("arn", journeyARN(meta.(*conns.AWSClient), applicationID, journeyID))
+	if err := d.Set("journey_channel_settings", flattenJourneyChannelSettings(journey.JourneyChannelSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting journey_channel_settings: %s", err)
+	}
+	d.Set("journey_id", journey.Id)
+	if err := d.Set("limits", flattenJourneyLimits(journey.Limits)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting limits: %s", err)
+	}
+	d.Set("local_time", journey.LocalTime)
+	d.Set("name", journey.Name)
+	if journey.QuietTime != nil && (aws.StringValue(journey.QuietTime.Start) != "" || aws.StringValue(journey.QuietTime.End) != "") {
+		if err := d.Set("quiet_time", flattenQuietTime(journey.QuietTime)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting quiet_time: %s", err)
+		}
+	} else {
+		d.Set("quiet_time", nil)
+	}
+	d.Set("refresh_frequency", journey.RefreshFrequency)
+	d.Set("refresh_on_segment_update", journey.RefreshOnSegmentUpdate)
+	if err := d.Set("schedule", flattenJourneySchedule(journey.Schedule)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting schedule: %s", err)
+	}
+	d.Set("sending_schedule", journey.SendingSchedule)
+	d.Set("start_activity", journey.StartActivity)
+	if err := d.Set("start_condition", flattenJourneyStartCondition(journey.StartCondition)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting start_condition: %s", err)
+	}
+	d.Set("state", journey.State)
+	d.Set("timezone_estimation_methods", aws.StringValueSlice(journey.TimezoneEstimationMethods))
+	d.Set("wait_for_quiet_time", journey.WaitForQuietTime)
+
+	setTagsOut(ctx, journey.Tags)
+
+	return diags
+}
+
+func resourceJourneyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), journeyResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	applicationID, journeyID := parts[0], parts[1]
+	o, n := d.GetChange("state")
+	oldState, newState := o.(string), n.(string)
+
+	// A journey in DRAFT state is published by updating the journey definition.
+	// All other state transitions are made via UpdateJourneyState.
+	if d.HasChangesExcept("state", "tags", "tags_all") || (d.HasChange("state") && oldState == pinpoint.StateDraft) {
+		request := expandWriteJourneyRequest(d)
+
+		if oldState == pinpoint.StateDraft {
+			request.State = aws.String(newState)
+		}
+
+		input := &pinpoint.UpdateJourneyInput{
+			ApplicationId:       aws.String(applicationID),
+			JourneyId:           aws.String(journeyID),
+			WriteJourneyRequest: request,
+		}
+
+		_, err := conn.UpdateJourneyWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Pinpoint Journey (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("state") && oldState != pinpoint.StateDraft {
+		input := &pinpoint.UpdateJourneyStateInput{
+			ApplicationId: aws.String(applicationID),
+			JourneyId:     aws.String(journeyID),
+			JourneyStateRequest: &pinpoint.JourneyStateRequest{
+				State: aws.String(newState),
+			},
+		}
+
+		_, err := conn.UpdateJourneyStateWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Pinpoint Journey (%s) state (%s): %s", d.Id(), newState, err)
+		}
+	}
+
+	return append(diags, resourceJourneyRead(ctx, d, meta)...)
+}
+
+func resourceJourneyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PinpointConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), journeyResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Pinpoint Journey: %s", d.Id())
+	_, err = conn.DeleteJourneyWithContext(ctx, &pinpoint.DeleteJourneyInput{
+		ApplicationId: aws.String(parts[0]),
+		JourneyId:     aws.String(parts[1]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pinpoint.ErrCodeNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Pinpoint Journey (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindJourneyByTwoPartKey(ctx context.Context, conn *pinpoint.Pinpoint, applicationID, journeyID string) (*pinpoint.JourneyResponse, error) {
+	input := &pinpoint.GetJourneyInput{
+		ApplicationId: aws.String(applicationID),
+		JourneyId:     aws.String(journeyID),
+	}
+
+	output, err := conn.GetJourneyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, pinpoint.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JourneyResponse == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.JourneyResponse, nil
+}
+
+func journeyARN(c *conns.AWSClient, applicationID, journeyID string) string {
+	return arn.ARN{
+		Partition: c.Partition,
+		Service:   "mobiletargeting",
+		Region:    c.Region,
+		AccountID: c.AccountID,
+		Resource:  fmt.Sprintf("apps/%s/journeys/%s", applicationID, journeyID),
+	}.String()
+}
+
+func expandWriteJourneyRequest(d *schema.ResourceData) *pinpoint.WriteJourneyRequest {
+	apiObject := &pinpoint.WriteJourneyRequest{
+		Name: aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("activity"); ok && v.(*schema.Set).Len() > 0 {
+		apiObject.Activities = expandJourneyActivities(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("journey_channel_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.JourneyChannelSettings = expandJourneyChannelSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("limits"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Limits = expandJourneyLimits(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("local_time"); ok {
+		apiObject.LocalTime = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("quiet_time"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.QuietTime = expandQuietTime(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("refresh_frequency"); ok {
+		apiObject.RefreshFrequency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("refresh_on_segment_update"); ok {
+		apiObject.RefreshOnSegmentUpdate = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("schedule"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Schedule = expandJourneySchedule(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("sending_schedule"); ok {
+		apiObject.SendingSchedule = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("start_activity"); ok {
+		apiObject.StartActivity = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("start_condition"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.StartCondition = expandJourneyStartCondition(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("timezone_estimation_methods"); ok && v.(*schema.Set).Len() > 0 {
+		apiObject.TimezoneEstimationMethods = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("wait_for_quiet_time"); ok {
+		apiObject.WaitForQuietTime = aws.Bool(v.(bool))
+	}
+
+	return apiObject
+}
+
+func expandJourneyActivities(tfList []interface{}) map[string]*pinpoint.Activity {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*pinpoint.Activity)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects[tfMap["name"].(string)] = expandJourneyActivity(tfMap)
+	}
+
+	return apiObjects
+}
+
+func expandJourneyActivity(tfMap map[string]interface{}) *pinpoint.Activity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.Activity{}
+
+	if v, ok := tfMap["conditional_split"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ConditionalSplit = expandConditionalSplitActivity(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["contact_center"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ContactCenter = &pinpoint.ContactCenterActivity{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["next_activity"].(string); ok && v != "" {
+				apiObject.ContactCenter.NextActivity = aws.String(v)
+			}
+		}
+	}
+
+	if v, ok := tfMap["custom"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CUSTOM = expandCustomMessageActivity(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["email"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EMAIL = expandEmailMessageActivity(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["holdout"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Holdout = expandHoldoutActivity(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["multi_condition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MultiCondition = expandMultiConditionalSplitActivity(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["push"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PUSH = expandPushMessageActivity(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["random_split"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RandomSplit = expandRandomSplitActivity(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sms"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SMS = expandSMSMessageActivity(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["wait"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Wait = expandWaitActivity(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandConditionalSplitActivity(tfMap map[string]interface{}) *pinpoint.ConditionalSplitActivity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.ConditionalSplitActivity{}
+
+	if v, ok := tfMap["condition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Condition = expandCondition(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["evaluation_wait_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EvaluationWaitTime = expandWaitTime(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["false_activity"].(string); ok && v != "" {
+		apiObject.FalseActivity = aws.String(v)
+	}
+
+	if v, ok := tfMap["true_activity"].(string); ok && v != "" {
+		apiObject.TrueActivity = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCondition(tfMap map[string]interface{}) *pinpoint.Condition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.Condition{}
+
+	if v, ok := tfMap["conditions"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Conditions = expandSimpleConditions(v)
+	}
+
+	if v, ok := tfMap["operator"].(string); ok && v != "" {
+		apiObject.Operator = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSimpleConditions(tfList []interface{}) []*pinpoint.SimpleCondition {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*pinpoint.SimpleCondition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandSimpleCondition(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandSimpleCondition(tfMap map[string]interface{}) *pinpoint.SimpleCondition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.SimpleCondition{}
+
+	if v, ok := tfMap["event_condition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EventCondition = expandEventCondition(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["segment_condition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SegmentCondition = expandSegmentCondition(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandEventCondition(tfMap map[string]interface{}) *pinpoint.EventCondition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.EventCondition{}
+
+	if v, ok := tfMap["dimensions"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Dimensions = expandEventDimensions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["message_activity"].(string); ok && v != "" {
+		apiObject.MessageActivity = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSegmentCondition(tfMap map[string]interface{}) *pinpoint.SegmentCondition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.SegmentCondition{}
+
+	if v, ok := tfMap["segment_id"].(string); ok && v != "" {
+		apiObject.SegmentId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandEventDimensions(tfMap map[string]interface{}) *pinpoint.EventDimensions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.EventDimensions{}
+
+	if v, ok := tfMap["attribute"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Attributes = make(map[string]*pinpoint.AttributeDimension)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			attribute := &pinpoint.AttributeDimension{
+				Values: flex.ExpandStringSet(tfMap["values"].(*schema.Set)),
+			}
+
+			if v, ok := tfMap["attribute_type"].(string); ok && v != "" {
+				attribute.AttributeType = aws.String(v)
+			}
+
+			apiObject.Attributes[tfMap["name"].(string)] = attribute
+		}
+	}
+
+	if v, ok := tfMap["event_type"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EventType = expandSetDimension(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["metric"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Metrics = make(map[string]*pinpoint.MetricDimension)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Metrics[tfMap["name"].(string)] = &pinpoint.MetricDimension{
+				ComparisonOperator: aws.String(tfMap["comparison_operator"].(string)),
+				Value:              aws.Float64(tfMap["value"].(float64)),
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandSetDimension(tfMap map[string]interface{}) *pinpoint.SetDimension {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.SetDimension{}
+
+	if v, ok := tfMap["dimension_type"].(string); ok && v != "" {
+		apiObject.DimensionType = aws.String(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Values = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandWaitTime(tfMap map[string]interface{}) *pinpoint.WaitTime {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.WaitTime{}
+
+	if v, ok := tfMap["wait_for"].(string); ok && v != "" {
+		apiObject.WaitFor = aws.String(v)
+	}
+
+	if v, ok := tfMap["wait_until"].(string); ok && v != "" {
+		apiObject.WaitUntil = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCustomMessageActivity(tfMap map[string]interface{}) *pinpoint.CustomMessageActivity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.CustomMessageActivity{}
+
+	if v, ok := tfMap["delivery_uri"].(string); ok && v != "" {
+		apiObject.DeliveryUri = aws.String(v)
+	}
+
+	if v, ok := tfMap["endpoint_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EndpointTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["message_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MessageConfig = &pinpoint.JourneyCustomMessage{}
+
+		if v, ok := v[0].(map[string]interface{})["data"].(string); ok && v != "" {
+			apiObject.MessageConfig.Data = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["next_activity"].(string); ok && v != "" {
+		apiObject.NextActivity = aws.String(v)
+	}
+
+	if v, ok := tfMap["template_name"].(string); ok && v != "" {
+		apiObject.TemplateName = aws.String(v)
+	}
+
+	if v, ok := tfMap["template_version"].(string); ok && v != "" {
+		apiObject.TemplateVersion = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandEmailMessageActivity(tfMap map[string]interface{}) *pinpoint.EmailMessageActivity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.EmailMessageActivity{}
+
+	if v, ok := tfMap["message_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MessageConfig = &pinpoint.JourneyEmailMessage{}
+
+		if v, ok := v[0].(map[string]interface{})["from_address"].(string); ok && v != "" {
+			apiObject.MessageConfig.FromAddress = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["next_activity"].(string); ok && v != "" {
+		apiObject.NextActivity = aws.String(v)
+	}
+
+	if v, ok := tfMap["template_name"].(string); ok && v != "" {
+		apiObject.TemplateName = aws.String(v)
+	}
+
+	if v, ok := tfMap["template_version"].(string); ok && v != "" {
+		apiObject.TemplateVersion = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandHoldoutActivity(tfMap map[string]interface{}) *pinpoint.HoldoutActivity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.HoldoutActivity{
+		Percentage: aws.Int64(int64(tfMap["percentage"].(int))),
+	}
+
+	if v, ok := tfMap["next_activity"].(string); ok && v != "" {
+		apiObject.NextActivity = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMultiConditionalSplitActivity(tfMap map[string]interface{}) *pinpoint.MultiConditionalSplitActivity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.MultiConditionalSplitActivity{}
+
+	if v, ok := tfMap["branch"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			branch := &pinpoint.MultiConditionalBranch{}
+
+			if v, ok := tfMap["condition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				branch.Condition = expandSimpleCondition(v[0].(map[string]interface{}))
+			}
+
+			if v, ok := tfMap["next_activity"].(string); ok && v != "" {
+				branch.NextActivity = aws.String(v)
+			}
+
+			apiObject.Branches = append(apiObject.Branches, branch)
+		}
+	}
+
+	if v, ok := tfMap["default_activity"].(string); ok && v != "" {
+		apiObject.DefaultActivity = aws.String(v)
+	}
+
+	if v, ok := tfMap["evaluation_wait_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EvaluationWaitTime = expandWaitTime(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandPushMessageActivity(tfMap map[string]interface{}) *pinpoint.PushMessageActivity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.PushMessageActivity{}
+
+	if v, ok := tfMap["message_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MessageConfig = &pinpoint.JourneyPushMessage{}
+
+		if v, ok := v[0].(map[string]interface{})["time_to_live"].(string); ok && v != "" {
+			apiObject.MessageConfig.TimeToLive = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["next_activity"].(string); ok && v != "" {
+		apiObject.NextActivity = aws.String(v)
+	}
+
+	if v, ok := tfMap["template_name"].(string); ok && v != "" {
+		apiObject.TemplateName = aws.String(v)
+	}
+
+	if v, ok := tfMap["template_version"].(string); ok && v != "" {
+		apiObject.TemplateVersion = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRandomSplitActivity(tfMap map[string]interface{}) *pinpoint.RandomSplitActivity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.RandomSplitActivity{}
+
+	if v, ok := tfMap["branch"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			branch := &pinpoint.RandomSplitEntry{}
+
+			if v, ok := tfMap["next_activity"].(string); ok && v != "" {
+				branch.NextActivity = aws.String(v)
+			}
+
+			if v, ok := tfMap["percentage"].(int); ok {
+				branch.Percentage = aws.Int64(int64(v))
+			}
+
+			apiObject.Branches = append(apiObject.Branches, branch)
+		}
+	}
+
+	return apiObject
+}
+
+func expandSMSMessageActivity(tfMap map[string]interface{}) *pinpoint.SMSMessageActivity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.SMSMessageActivity{}
+
+	if v, ok := tfMap["message_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.MessageConfig = &pinpoint.JourneySMSMessage{}
+
+		if v, ok := tfMap["entity_id"].(string); ok && v != "" {
+			apiObject.MessageConfig.EntityId = aws.String(v)
+		}
+
+		if v, ok := tfMap["message_type"].(string); ok && v != "" {
+			apiObject.MessageConfig.MessageType = aws.String(v)
+		}
+
+		if v, ok := tfMap["origination_number"].(string); ok && v != "" {
+			apiObject.MessageConfig.OriginationNumber = aws.String(v)
+		}
+
+		if v, ok := tfMap["sender_id"].(string); ok && v != "" {
+			apiObject.MessageConfig.SenderId = aws.String(v)
+		}
+
+		if v, ok := tfMap["template_id"].(string); ok && v != "" {
+			apiObject.MessageConfig.TemplateId = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["next_activity"].(string); ok && v != "" {
+		apiObject.NextActivity = aws.String(v)
+	}
+
+	if v, ok := tfMap["template_name"].(string); ok && v != "" {
+		apiObject.TemplateName = aws.String(v)
+	}
+
+	if v, ok := tfMap["template_version"].(string); ok && v != "" {
+		apiObject.TemplateVersion = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandWaitActivity(tfMap map[string]interface{}) *pinpoint.WaitActivity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.WaitActivity{}
+
+	if v, ok := tfMap["next_activity"].(string); ok && v != "" {
+		apiObject.NextActivity = aws.String(v)
+	}
+
+	if v, ok := tfMap["wait_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.WaitTime = expandWaitTime(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandJourneyChannelSettings(tfMap map[string]interface{}) *pinpoint.JourneyChannelSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.JourneyChannelSettings{}
+
+	if v, ok := tfMap["connect_campaign_arn"].(string); ok && v != "" {
+		apiObject.ConnectCampaignArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["connect_campaign_execution_role_arn"].(string); ok && v != "" {
+		apiObject.ConnectCampaignExecutionRoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJourneyLimits(tfMap map[string]interface{}) *pinpoint.JourneyLimits {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.JourneyLimits{}
+
+	if v, ok := tfMap["daily_cap"].(int); ok && v != 0 {
+		apiObject.DailyCap = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["endpoint_reentry_cap"].(int); ok && v != 0 {
+		apiObject.EndpointReentryCap = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["endpoint_reentry_interval"].(string); ok && v != "" {
+		apiObject.EndpointReentryInterval = aws.String(v)
+	}
+
+	if v, ok := tfMap["messages_per_second"].(int); ok && v != 0 {
+		apiObject.MessagesPerSecond = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["timeframe_cap"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.TimeframeCap = &pinpoint.JourneyTimeframeCap{}
+
+		if v, ok := tfMap["cap"].(int); ok && v != 0 {
+			apiObject.TimeframeCap.Cap = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["days"].(int); ok && v != 0 {
+			apiObject.TimeframeCap.Days = aws.Int64(int64(v))
+		}
+	}
+
+	if v, ok := tfMap["total_cap"].(int); ok && v != 0 {
+		apiObject.TotalCap = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandJourneySchedule(tfMap map[string]interface{}) *pinpoint.JourneySchedule {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.JourneySchedule{}
+
+	if v, ok := tfMap["end_time"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+
+		apiObject.EndTime = aws.Time(v)
+	}
+
+	if v, ok := tfMap["start_time"].(string); ok && v != "" {
+		v, _ := time.Parse(time.RFC3339, v)
+
+		apiObject.StartTime = aws.Time(v)
+	}
+
+	if v, ok := tfMap["timezone"].(string); ok && v != "" {
+		apiObject.Timezone = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJourneyStartCondition(tfMap map[string]interface{}) *pinpoint.StartCondition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.StartCondition{}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["event_start_condition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.EventStartCondition = &pinpoint.EventStartCondition{}
+
+		if v, ok := tfMap["event_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.EventStartCondition.EventFilter = &pinpoint.EventFilter{
+				FilterType: aws.String(tfMap["filter_type"].(string)),
+			}
+
+			if v, ok := tfMap["dimensions"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.EventStartCondition.EventFilter.Dimensions = expandEventDimensions(v[0].(map[string]interface{}))
+			}
+		}
+
+		if v, ok := tfMap["segment_id"].(string); ok && v != "" {
+			apiObject.EventStartCondition.SegmentId = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["segment_start_condition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SegmentStartCondition = expandSegmentCondition(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func flattenJourneyActivities(apiObjects map[string]*pinpoint.Activity) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := flattenJourneyActivity(apiObject)
+		tfMap["name"] = name
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenJourneyActivity(apiObject *pinpoint.Activity) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ConditionalSplit; v != nil {
+		tfMap["conditional_split"] = []interface{}{flattenConditionalSplitActivity(v)}
+	}
+
+	if v := apiObject.ContactCenter; v != nil {
+		tfMap["contact_center"] = []interface{}{map[string]interface{}{
+			"next_activity": aws.StringValue(v.NextActivity),
+		}}
+	}
+
+	if v := apiObject.CUSTOM; v != nil {
+		tfMap["custom"] = []interface{}{flattenCustomMessageActivity(v)}
+	}
+
+	if v := apiObject.Description; v != nil {
+		tfMap["description"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EMAIL; v != nil {
+		tfMap["email"] = []interface{}{flattenEmailMessageActivity(v)}
+	}
+
+	if v := apiObject.Holdout; v != nil {
+		tfMap["holdout"] = []interface{}{map[string]interface{}{
+			"next_activity": aws.StringValue(v.NextActivity),
+			"percentage":    aws.Int64Value(v.Percentage),
+		}}
+	}
+
+	if v := apiObject.MultiCondition; v != nil {
+		tfMap["multi_condition"] = []interface{}{flattenMultiConditionalSplitActivity(v)}
+	}
+
+	if v := apiObject.PUSH; v != nil {
+		tfMap["push"] = []interface{}{flattenPushMessageActivity(v)}
+	}
+
+	if v := apiObject.RandomSplit; v != nil {
+		tfMap["random_split"] = []interface{}{flattenRandomSplitActivity(v)}
+	}
+
+	if v := apiObject.SMS; v != nil {
+		tfMap["sms"] = []interface{}{flattenSMSMessageActivity(v)}
+	}
+
+	if v := apiObject.Wait; v != nil {
+		tfMap["wait"] = []interface{}{flattenWaitActivity(v)}
+	}
+
+	return tfMap
+}
+
+func flattenConditionalSplitActivity(apiObject *pinpoint.ConditionalSplitActivity) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"false_activity": aws.StringValue(apiObject.FalseActivity),
+		"true_activity":  aws.StringValue(apiObject.TrueActivity),
+	}
+
+	if v := apiObject.Condition; v != nil {
+		tfMap["condition"] = []interface{}{map[string]interface{}{
+			"conditions": flattenSimpleConditions(v.Conditions),
+			"operator":   aws.StringValue(v.Operator),
+		}}
+	}
+
+	if v := apiObject.EvaluationWaitTime; v != nil {
+		tfMap["evaluation_wait_time"] = []interface{}{flattenWaitTime(v)}
+	}
+
+	return tfMap
+}
+
+func flattenSimpleConditions(apiObjects []*pinpoint.SimpleCondition) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenSimpleCondition(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenSimpleCondition(apiObject *pinpoint.SimpleCondition) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EventCondition; v != nil {
+		eventCondition := map[string]interface{}{
+			"message_activity": aws.StringValue(v.MessageActivity),
+		}
+
+		if v := v.Dimensions; v != nil {
+			eventCondition["dimensions"] = []interface{}{flattenEventDimensions(v)}
+		}
+
+		tfMap["event_condition"] = []interface{}{eventCondition}
+	}
+
+	if v := apiObject.SegmentCondition; v != nil {
+		tfMap["segment_condition"] = []interface{}{map[string]interface{}{
+			"segment_id": aws.StringValue(v.SegmentId),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenEventDimensions(apiObject *pinpoint.EventDimensions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Attributes; len(v) > 0 {
+		var tfList []interface{}
+
+		for name, apiObject := range v {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"attribute_type": aws.StringValue(apiObject.AttributeType),
+				"name":           name,
+				"values":         aws.StringValueSlice(apiObject.Values),
+			})
+		}
+
+		tfMap["attribute"] = tfList
+	}
+
+	if v := apiObject.EventType; v != nil {
+		tfMap["event_type"] = []interface{}{map[string]interface{}{
+			"dimension_type": aws.StringValue(v.DimensionType),
+			"values":         aws.StringValueSlice(v.Values),
+		}}
+	}
+
+	if v := apiObject.Metrics; len(v) > 0 {
+		var tfList []interface{}
+
+		for name, apiObject := range v {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"comparison_operator": aws.StringValue(apiObject.ComparisonOperator),
+				"name":                name,
+				"value":               aws.Float64Value(apiObject.Value),
+			})
+		}
+
+		tfMap["metric"] = tfList
+	}
+
+	return tfMap
+}
+
+func flattenWaitTime(apiObject *pinpoint.WaitTime) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"wait_for":   aws.StringValue(apiObject.WaitFor),
+		"wait_until": aws.StringValue(apiObject.WaitUntil),
+	}
+}
+
+func flattenCustomMessageActivity(apiObject *pinpoint.CustomMessageActivity) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"delivery_uri":     aws.StringValue(apiObject.DeliveryUri),
+		"endpoint_types":   aws.StringValueSlice(apiObject.EndpointTypes),
+		"next_activity":    aws.StringValue(apiObject.NextActivity),
+		"template_name":    aws.StringValue(apiObject.TemplateName),
+		"template_version": aws.StringValue(apiObject.TemplateVersion),
+	}
+
+	if v := apiObject.MessageConfig; v != nil {
+		tfMap["message_config"] = []interface{}{map[string]interface{}{
+			"data": aws.StringValue(v.Data),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenEmailMessageActivity(apiObject *pinpoint.EmailMessageActivity) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"next_activity":    aws.StringValue(apiObject.NextActivity),
+		"template_name":    aws.StringValue(apiObject.TemplateName),
+		"template_version": aws.StringValue(apiObject.TemplateVersion),
+	}
+
+	if v := apiObject.MessageConfig; v != nil {
+		tfMap["message_config"] = []interface{}{map[string]interface{}{
+			"from_address": aws.StringValue(v.FromAddress),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenMultiConditionalSplitActivity(apiObject *pinpoint.MultiConditionalSplitActivity) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"default_activity": aws.StringValue(apiObject.DefaultActivity),
+	}
+
+	if v := apiObject.Branches; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			if apiObject == nil {
+				continue
+			}
+
+			branch := map[string]interface{}{
+				"next_activity": aws.StringValue(apiObject.NextActivity),
+			}
+
+			if v := apiObject.Condition; v != nil {
+				branch["condition"] = []interface{}{flattenSimpleCondition(v)}
+			}
+
+			tfList = append(tfList, branch)
+		}
+
+		tfMap["branch"] = tfList
+	}
+
+	if v := apiObject.EvaluationWaitTime; v != nil {
+		tfMap["evaluation_wait_time"] = []interface{}{flattenWaitTime(v)}
+	}
+
+	return tfMap
+}
+
+func flattenPushMessageActivity(apiObject *pinpoint.PushMessageActivity) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"next_activity":    aws.StringValue(apiObject.NextActivity),
+		"template_name":    aws.StringValue(apiObject.TemplateName),
+		"template_version": aws.StringValue(apiObject.TemplateVersion),
+	}
+
+	if v := apiObject.MessageConfig; v != nil {
+		tfMap["message_config"] = []interface{}{map[string]interface{}{
+			"time_to_live": aws.StringValue(v.TimeToLive),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenRandomSplitActivity(apiObject *pinpoint.RandomSplitActivity) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Branches; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"next_activity": aws.StringValue(apiObject.NextActivity),
+				"percentage":    aws.Int64Value(apiObject.Percentage),
+			})
+		}
+
+		tfMap["branch"] = tfList
+	}
+
+	return tfMap
+}
+
+func flattenSMSMessageActivity(apiObject *pinpoint.SMSMessageActivity) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"next_activity":    aws.StringValue(apiObject.NextActivity),
+		"template_name":    aws.StringValue(apiObject.TemplateName),
+		"template_version": aws.StringValue(apiObject.TemplateVersion),
+	}
+
+	if v := apiObject.MessageConfig; v != nil {
+		tfMap["message_config"] = []interface{}{map[string]interface{}{
+			"entity_id":          aws.StringValue(v.EntityId),
+			"message_type":       aws.StringValue(v.MessageType),
+			"origination_number": aws.StringValue(v.OriginationNumber),
+			"sender_id":          aws.StringValue(v.SenderId),
+			"template_id":        aws.StringValue(v.TemplateId),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenWaitActivity(apiObject *pinpoint.WaitActivity) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"next_activity": aws.StringValue(apiObject.NextActivity),
+	}
+
+	if v := apiObject.WaitTime; v != nil {
+		tfMap["wait_time"] = []interface{}{flattenWaitTime(v)}
+	}
+
+	return tfMap
+}
+
+func flattenJourneyChannelSettings(apiObject *pinpoint.JourneyChannelSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"connect_campaign_arn":                aws.StringValue(apiObject.ConnectCampaignArn),
+		"connect_campaign_execution_role_arn": aws.StringValue(apiObject.ConnectCampaignExecutionRoleArn),
+	}}
+}
+
+func flattenJourneyLimits(apiObject *pinpoint.JourneyLimits) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"daily_cap":                 aws.Int64Value(apiObject.DailyCap),
+		"endpoint_reentry_cap":      aws.Int64Value(apiObject.EndpointReentryCap),
+		"endpoint_reentry_interval": aws.StringValue(apiObject.EndpointReentryInterval),
+		"messages_per_second":       aws.Int64Value(apiObject.MessagesPerSecond),
+		"total_cap":                 aws.Int64Value(apiObject.TotalCap),
+	}
+
+	if v := apiObject.TimeframeCap; v != nil {
+		tfMap["timeframe_cap"] = []interface{}{map[string]interface{}{
+			"cap":  aws.Int64Value(v.Cap),
+			"days": aws.Int64Value(v.Days),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenJourneySchedule(apiObject *pinpoint.JourneySchedule) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"timezone": aws.StringValue(apiObject.Timezone),
+	}
+
+	if v := apiObject.EndTime; v != nil {
+		tfMap["end_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.StartTime; v != nil {
+		tfMap["start_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenJourneyStartCondition(apiObject *pinpoint.StartCondition) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"description": aws.StringValue(apiObject.Description),
+	}
+
+	if v := apiObject.EventStartCondition; v != nil {
+		eventStartCondition := map[string]interface{}{
+			"segment_id": aws.StringValue(v.SegmentId),
+		}
+
+		if v := v.EventFilter; v != nil {
+			eventFilter := map[string]interface{}{
+				"filter_type": aws.StringValue(v.FilterType),
+			}
+
+			if v := v.Dimensions; v != nil {
+				eventFilter["dimensions"] = []interface{}{flattenEventDimensions(v)}
+			}
+
+			eventStartCondition["event_filter"] = []interface{}{eventFilter}
+		}
+
+		tfMap["event_start_condition"] = []interface{}{eventStartCondition}
+	}
+
+	if v := apiObject.SegmentStartCondition; v != nil {
+		tfMap["segment_start_condition"] = []interface{}{map[string]interface{}{
+			"segment_id": aws.StringValue(v.SegmentId),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,389 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pinpoint_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpoint "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPinpointJourney_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var journey pinpoint.JourneyResponse
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_journey.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckApp(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJourneyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJourneyConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJourneyExists(ctx, resourceName, &journey),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_pinpoint_app.test", "application_id"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mobiletargeting", regexache.MustCompile(`apps/.+/journeys/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "journey_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "start_activity", "wait"),
+					resource.TestCheckResourceAttr(resourceName, "state", pinpoint.StateDraft),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointJourney_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var journey pinpoint.JourneyResponse
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_journey.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckApp(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJourneyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJourneyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJourneyExists(ctx, resourceName, &journey),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfpinpoint.ResourceJourney(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointJourney_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var journey pinpoint.JourneyResponse
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_journey.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckApp(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJourneyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJourneyConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJourneyExists(ctx, resourceName, &journey),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJourneyConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJourneyExists(ctx, resourceName, &journey),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccJourneyConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJourneyExists(ctx, resourceName, &journey),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPinpointJourney_activities(t *testing.T) {
+	ctx := acctest.Context(t)
+	var journey pinpoint.JourneyResponse
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpoint_journey.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckApp(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJourneyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJourneyConfig_activities(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJourneyExists(ctx, resourceName, &journey),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "4"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "activity.*", map[string]string{
+						"name":                               "split",
+						"random_split.#":                     "1",
+						"random_split.0.branch.#":            "2",
+						"random_split.0.branch.0.percentage": "50",
+						"random_split.0.branch.1.percentage": "50",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "activity.*", map[string]string{
+						"name":                    "holdout",
+						"holdout.#":               "1",
+						"holdout.0.percentage":    "10",
+						"holdout.0.next_activity": "wait",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "activity.*", map[string]string{
+						"name":                        "wait",
+						"wait.#":                      "1",
+						"wait.0.wait_time.0.wait_for": "PT1H",
+						"wait.0.next_activity":        "condition",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "activity.*", map[string]string{
+						"name":                "condition",
+						"conditional_split.#": "1",
+						"conditional_split.0.condition.0.operator":            "ALL",
+						"conditional_split.0.evaluation_wait_time.0.wait_for": "PT1H",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "limits.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "limits.0.daily_cap", "1"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_time.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_time.0.start", "22:00"),
+					resource.TestCheckResourceAttr(resourceName, "quiet_time.0.end", "06:00"),
+					resource.TestCheckResourceAttr(resourceName, "start_activity", "split"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckJourneyExists(ctx context.Context, n string, v *pinpoint.JourneyResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn(ctx)
+
+		output, err := tfpinpoint.FindJourneyByTwoPartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.Attributes["journey_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckJourneyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pinpoint_journey" {
+				continue
+			}
+
+			_, err := tfpinpoint.FindJourneyByTwoPartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.Attributes["journey_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Pinpoint Journey %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccJourneyConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccJourneyConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccJourneyConfig_base(rName), fmt.Sprintf(`
+resource "aws_pinpoint_journey" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+  start_activity = "wait"
+
+  activity {
+    name = "wait"
+
+    wait {
+      wait_time {
+        wait_for = "PT1H"
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccJourneyConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccJourneyConfig_base(rName), fmt.Sprintf(`
+resource "aws_pinpoint_journey" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+  start_activity = "wait"
+
+  activity {
+    name = "wait"
+
+    wait {
+      wait_time {
+        wait_for = "PT1H"
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccJourneyConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccJourneyConfig_base(rName), fmt.Sprintf(`
+resource "aws_pinpoint_journey" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+  start_activity = "wait"
+
+  activity {
+    name = "wait"
+
+    wait {
+      wait_time {
+        wait_for = "PT1H"
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccJourneyConfig_activities(rName string) string {
+	return acctest.ConfigCompose(testAccJourneyConfig_base(rName), fmt.Sprintf(`
+resource "aws_pinpoint_journey" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+  start_activity = "split"
+
+  activity {
+    name = "split"
+
+    random_split {
+      branch {
+        next_activity = "holdout"
+        percentage    = 50
+      }
+
+      branch {
+        next_activity = "wait"
+        percentage    = 50
+      }
+    }
+  }
+
+  activity {
+    name = "holdout"
+
+    holdout {
+      next_activity = "wait"
+      percentage    = 10
+    }
+  }
+
+  activity {
+    name = "wait"
+
+    wait {
+      next_activity = "condition"
+
+      wait_time {
+        wait_for = "PT1H"
+      }
+    }
+  }
+
+  activity {
+    name = "condition"
+
+    conditional_split {
+      condition {
+        operator = "ALL"
+
+        conditions {
+          event_condition {
+            dimensions {
+              event_type {
+                dimension_type = "INCLUSIVE"
+                values         = ["_email.click"]
+              }
+            }
+          }
+        }
+      }
+
+      evaluation_wait_time {
+        wait_for = "PT1H"
+      }
+    }
+  }
+
+  limits {
+    daily_cap = 1
+  }
+
+  quiet_time {
+    end   = "06:00"
+    start = "22:00"
+  }
+}
+`, rName))
+}
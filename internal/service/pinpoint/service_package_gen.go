@@ -73,6 +73,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGCMChannel,
 			TypeName: "aws_pinpoint_gcm_channel",
 		},
+		{
+			Factory:  ResourceJourney,
+			TypeName: "aws_pinpoint_journey",
+			Name:     "Journey",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceSMSChannel,
 			TypeName: "aws_pinpoint_sms_channel",
@@ -59,7 +59,7 @@ func ResourceDataSource() *schema.Resource {
 								Type:          schema.TypeString,
 								Optional:      true,
 								ValidateFunc:  verify.ValidARN,
-								ConflictsWith: []string{"credentials.0.credential_pair"},
+								ConflictsWith: []string{"credentials.0.credential_pair", "credentials.0.secret_arn"},
 							},
 							"credential_pair": {
 								Type:     schema.TypeList,
@@ -87,7 +87,13 @@ func ResourceDataSource() *schema.Resource {
 										},
 									},
 								},
-								ConflictsWith: []string{"credentials.0.copy_source_arn"},
+								ConflictsWith: []string{"credentials.0.copy_source_arn", "credentials.0.secret_arn"},
+							},
+							"secret_arn": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								ValidateFunc:  verify.ValidARN,
+								ConflictsWith: []string{"credentials.0.copy_source_arn", "credentials.0.credential_pair"},
 							},
 						},
 					},
@@ -848,6 +854,10 @@ func expandDataSourceCredentials(tfList []interface{}) *quicksight.DataSourceCre
 		credentials.CredentialPair = expandDataSourceCredentialPair(v)
 	}
 
+	if v, ok := tfMap["secret_arn"].(string); ok && v != "" {
+		credentials.SecretArn = aws.String(v)
+	}
+
 	return credentials
 }
 
@@ -237,6 +237,45 @@ func TestAccQuickSightDataSource_name(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightDataSource_snowflake(t *testing.T) {
+	ctx := acctest.Context(t)
+	host := acctest.SkipIfEnvVarNotSet(t, "QUICKSIGHT_SNOWFLAKE_HOST")
+	database := acctest.SkipIfEnvVarNotSet(t, "QUICKSIGHT_SNOWFLAKE_DATABASE")
+	warehouse := acctest.SkipIfEnvVarNotSet(t, "QUICKSIGHT_SNOWFLAKE_WAREHOUSE")
+	secretARN := acctest.SkipIfEnvVarNotSet(t, "QUICKSIGHT_SNOWFLAKE_SECRET_ARN")
+	var dataSource quicksight.DataSource
+	resourceName := "aws_quicksight_data_source.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConfig_snowflake(rId, rName, host, database, warehouse, secretARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.snowflake.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.snowflake.0.database", database),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.snowflake.0.host", host),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.snowflake.0.warehouse", warehouse),
+					resource.TestCheckResourceAttr(resourceName, "type", quicksight.DataSourceTypeSnowflake),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials"},
+			},
+		},
+	})
+}
+
 func testAccCheckDataSourceExists(ctx context.Context, resourceName string, dataSource *quicksight.DataSource) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -409,6 +448,29 @@ resource "aws_quicksight_data_source" "test" {
 `, rId, rName))
 }
 
+func testAccDataSourceConfig_snowflake(rId, rName, host, database, warehouse, secretARN string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_data_source" "test" {
+  data_source_id = %[1]q
+  name           = %[2]q
+
+  credentials {
+    secret_arn = %[6]q
+  }
+
+  parameters {
+    snowflake {
+      database  = %[4]q
+      host      = %[3]q
+      warehouse = %[5]q
+    }
+  }
+
+  type = "SNOWFLAKE"
+}
+`, rId, rName, host, database, warehouse, secretARN)
+}
+
 func testAccDataSourceConfig_tags1(rId, rName, key, value string) string {
 	return acctest.ConfigCompose(
 		testAccBaseDataSourceConfig(rName),
@@ -239,9 +239,27 @@ func (r *resourceExportTask) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	// Attempt to cancel the export task, but ignore errors where the task is in an invalid
-	// state (ie. completed or failed) which can't be cancelled
-	_, err := conn.CancelExportTask(ctx, &rds.CancelExportTaskInput{
+	out, err := FindExportTaskByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionDeleting, ResNameExportTask, state.ID.String(), nil),
+			err.Error(),
+		)
+		return
+	}
+
+	// Completed, failed, and canceled export tasks can't be cancelled, so they are
+	// simply removed from state
+	if status := aws.ToString(out.Status); status != StatusStarting && status != StatusInProgress {
+		return
+	}
+
+	// Ignore errors where the task has transitioned to a state which can't be cancelled
+	// since it was read above
+	_, err = conn.CancelExportTask(ctx, &rds.CancelExportTaskInput{
 		ExportTaskIdentifier: aws.String(state.ID.ValueString()),
 	})
 	if err != nil {
@@ -253,6 +271,7 @@ func (r *resourceExportTask) Delete(ctx context.Context, req resource.DeleteRequ
 			create.ProblemStandardMessage(names.RDS, create.ErrActionDeleting, ResNameExportTask, state.ID.String(), nil),
 			err.Error(),
 		)
+		return
 	}
 
 	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
@@ -327,7 +346,7 @@ func waitExportTaskCreated(ctx context.Context, conn *rds.Client, id string, tim
 func waitExportTaskDeleted(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*awstypes.ExportTask, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{StatusStarting, StatusInProgress, StatusCanceling},
-		Target:  []string{},
+		Target:  []string{StatusCanceled, StatusComplete, StatusFailed},
 		Refresh: statusExportTask(ctx, conn, id),
 		Timeout: timeout,
 	}
@@ -48,6 +48,8 @@ func TestAccRDSExportTask_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "s3_bucket_name", "aws_s3_bucket.test", "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", "aws_iam_role.test", "arn"),
 					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", tfrds.StatusComplete),
+					resource.TestCheckResourceAttr(resourceName, "percent_progress", "100"),
 				),
 			},
 			{
@@ -452,6 +452,40 @@ func TestAccRDSProxy_authUsername(t *testing.T) {
 	})
 }
 
+func TestAccRDSProxy_authClientPasswordAuthType(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbProxy rds.DBProxy
+	resourceName := "aws_db_proxy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccDBProxyPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProxyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProxyConfig_authClientPasswordAuthType(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProxyExists(ctx, resourceName, &dbProxy),
+					resource.TestCheckResourceAttr(resourceName, "engine_family", "POSTGRESQL"),
+					resource.TestCheckResourceAttr(resourceName, "auth.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth.0.client_password_auth_type", "POSTGRES_SCRAM_SHA_256"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccRDSProxy_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -949,6 +983,56 @@ resource "aws_secretsmanager_secret_version" "test2" {
 `, rName, nName)
 }
 
+func testAccProxyConfig_authClientPasswordAuthType(rName string) string {
+	return testAccProxyBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_db_subnet_group" "test" {
+  name       = "%[1]s"
+  subnet_ids = aws_subnet.test[*].id
+}
+
+data "aws_rds_engine_version" "test" {
+  engine = "aurora-postgresql"
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier     = "%[1]s"
+  db_subnet_group_name   = aws_db_subnet_group.test.id
+  engine                 = data.aws_rds_engine_version.test.engine
+  engine_version         = data.aws_rds_engine_version.test.version
+  master_username        = "db_user"
+  master_password        = "db_user_password"
+  skip_final_snapshot    = true
+  vpc_security_group_ids = [aws_security_group.test.id]
+}
+
+resource "aws_db_proxy" "test" {
+  depends_on = [
+    aws_secretsmanager_secret_version.test,
+    aws_iam_role_policy.test
+  ]
+
+  name                   = "%[1]s"
+  engine_family          = "POSTGRESQL"
+  role_arn               = aws_iam_role.test.arn
+  vpc_security_group_ids = [aws_security_group.test.id]
+  vpc_subnet_ids         = aws_subnet.test[*].id
+
+  auth {
+    auth_scheme               = "SECRETS"
+    client_password_auth_type = "POSTGRES_SCRAM_SHA_256"
+    iam_auth                  = "DISABLED"
+    secret_arn                = aws_secretsmanager_secret.test.arn
+  }
+}
+
+resource "aws_db_proxy_target" "test" {
+  db_cluster_identifier = aws_rds_cluster.test.cluster_identifier
+  db_proxy_name         = aws_db_proxy.test.name
+  target_group_name     = "default"
+}
+`, rName)
+}
+
 func testAccProxyConfig_tags(rName, key, value string) string {
 	return testAccProxyBaseConfig(rName) + fmt.Sprintf(`
 resource "aws_db_proxy" "test" {
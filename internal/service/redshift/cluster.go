@@ -299,6 +299,11 @@ func ResourceCluster() *schema.Resource {
 					validation.StringMatch(regexache.MustCompile(`(?i)^[a-z_]`), "first character must be a letter"),
 				),
 			},
+			"multi_az": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"node_type": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -497,6 +502,11 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.ManageMasterPassword = aws.Bool(v.(bool))
 	}
 
+	if v, ok := d.GetOk("multi_az"); ok {
+		backupInput.MultiAZ = aws.Bool(v.(bool))
+		input.MultiAZ = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("manual_snapshot_retention_period"); ok {
 		backupInput.ManualSnapshotRetentionPeriod = aws.Int64(int64(v.(int)))
 		input.ManualSnapshotRetentionPeriod = aws.Int64(int64(v.(int)))
@@ -680,6 +690,11 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("master_username", rsc.MasterUsername)
 	d.Set("master_password_secret_arn", rsc.MasterPasswordSecretArn)
 	d.Set("master_password_secret_kms_key_id", rsc.MasterPasswordSecretKmsKeyId)
+	multiAZ, err := clusterMultiAZStatus(rsc)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Cluster (%s): %s", d.Id(), err)
+	}
+	d.Set("multi_az", multiAZ)
 	d.Set("node_type", rsc.NodeType)
 	d.Set("number_of_nodes", rsc.NumberOfNodes)
 	d.Set("preferred_maintenance_window", rsc.PreferredMaintenanceWindow)
@@ -796,6 +811,10 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.ManageMasterPassword = aws.Bool(d.Get("manage_master_password").(bool))
 		}
 
+		if d.HasChange("multi_az") {
+			input.MultiAZ = aws.Bool(d.Get("multi_az").(bool))
+		}
+
 		if d.HasChange("preferred_maintenance_window") {
 			input.PreferredMaintenanceWindow = aws.String(d.Get("preferred_maintenance_window").(string))
 		}
@@ -1135,3 +1154,15 @@ func clusterAvailabilityZoneRelocationStatus(cluster *redshift.Cluster) (bool, e
 		return false, fmt.Errorf("unexpected AvailabilityZoneRelocationStatus value %q returned by API", availabilityZoneRelocationStatus)
 	}
 }
+
+func clusterMultiAZStatus(cluster *redshift.Cluster) (bool, error) {
+	// MultiAZ is returned as a string by the API and is not implemented as Const at this time.
+	switch multiAZStatus := strings.ToLower(aws.StringValue(cluster.MultiAZ)); multiAZStatus {
+	case "enabled":
+		return true, nil
+	case "disabled", "":
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected MultiAZ value %q returned by API", multiAZStatus)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -353,6 +354,57 @@ func TestAccRoute53ResolverRule_forwardEndpointRecreate(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverRule_forwardEndpointChangedWithAssociation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule1, rule2 route53resolver.ResolverRule
+	var association1, association2 route53resolver.ResolverRuleAssociation
+	resourceName := "aws_route53_resolver_rule.test"
+	associationResourceName := "aws_route53_resolver_rule_association.test"
+	ep1ResourceName := "aws_route53_resolver_endpoint.test.0"
+	ep2ResourceName := "aws_route53_resolver_endpoint.test.1"
+	domainName := acctest.RandomDomainName()
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53resolver.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig_forwardAssociated(rName, domainName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &rule1),
+					testAccCheckRuleAssociationExists(ctx, associationResourceName, &association1),
+					resource.TestCheckResourceAttrPair(resourceName, "resolver_endpoint_id", ep1ResourceName, "id"),
+				),
+			},
+			{
+				Config: testAccRuleConfig_forwardAssociated(rName, domainName, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(associationResourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &rule2),
+					testAccCheckRulesSame(&rule2, &rule1),
+					testAccCheckRuleAssociationExists(ctx, associationResourceName, &association2),
+					resource.TestCheckResourceAttrPair(resourceName, "resolver_endpoint_id", ep2ResourceName, "id"),
+					func(s *terraform.State) error {
+						if before, after := aws.StringValue(association1.Id), aws.StringValue(association2.Id); before != after {
+							return fmt.Errorf("Expected Route53 Resolver Rule Association IDs to be the same. But they were: %s, %s", before, after)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRulesSame(before, after *route53resolver.ResolverRule) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if before, after := aws.StringValue(before.Arn), aws.StringValue(after.Arn); before != after {
@@ -543,6 +595,28 @@ resource "aws_route53_resolver_rule" "test" {
 `, rName, domainName))
 }
 
+func testAccRuleConfig_forwardAssociated(rName, domainName string, endpointIndex int) string {
+	return acctest.ConfigCompose(testAccRuleConfig_resolverEndpointBase(rName), fmt.Sprintf(`
+resource "aws_route53_resolver_rule" "test" {
+  domain_name = %[2]q
+  rule_type   = "FORWARD"
+  name        = %[1]q
+
+  resolver_endpoint_id = aws_route53_resolver_endpoint.test[%[3]d].id
+
+  target_ip {
+    ip = "192.0.2.6"
+  }
+}
+
+resource "aws_route53_resolver_rule_association" "test" {
+  name             = %[1]q
+  resolver_rule_id = aws_route53_resolver_rule.test.id
+  vpc_id           = aws_vpc.test.id
+}
+`, rName, domainName, endpointIndex))
+}
+
 func testAccRuleConfig_vpcBase(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_vpc" "test" {
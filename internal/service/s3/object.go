@@ -6,6 +6,7 @@ package s3
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
 	"io"
@@ -590,6 +591,15 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
 	}
 
+	var checksumSHA256 string
+	if input.ChecksumAlgorithm == types.ChecksumAlgorithmSha256 {
+		v, err := objectChecksumSHA256(body)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "computing S3 Object (%s) SHA-256 checksum: %s", aws.ToString(input.Key), err)
+		}
+		checksumSHA256 = v
+	}
+
 	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
 
 	if _, err := uploader.Upload(ctx, input); err != nil {
@@ -600,7 +610,34 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		d.SetId(d.Get("key").(string))
 	}
 
-	return append(diags, resourceObjectRead(ctx, d, meta)...)
+	diags = append(diags, resourceObjectRead(ctx, d, meta)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// Multipart uploads report a checksum of the part checksums suffixed with the part count, which can't be compared with the object's checksum.
+	// Returning an error from Create leaves the new object tainted.
+	if v := d.Get("checksum_sha256").(string); checksumSHA256 != "" && v != "" && !strings.Contains(v, "-") && v != checksumSHA256 {
+		return sdkdiag.AppendErrorf(diags, "S3 Object (%s) SHA-256 checksum (%s) does not match locally computed checksum (%s)", d.Id(), v, checksumSHA256)
+	}
+
+	return diags
+}
+
+// objectChecksumSHA256 returns the base64-encoded SHA-256 digest of body and rewinds it.
+func objectChecksumSHA256(body io.ReadSeeker) (string, error) {
+	h := sha256.New()
+
+	if _, err := io.Copy(h, body); err != nil {
+		return "", err
+	}
+
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
 }
 
 func setObjectKMSKeyID(ctx context.Context, meta interface{}, d *schema.ResourceData, sseKMSKeyID string) error {
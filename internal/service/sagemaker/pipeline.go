@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -63,6 +64,11 @@ func ResourcePipeline() *schema.Resource {
 					validation.StringLenBetween(1, 1048576),
 					validation.StringIsJSON,
 				),
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 			},
 			"pipeline_definition_s3_location": {
 				Type:         schema.TypeList,
@@ -158,6 +164,10 @@ func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(name)
 
+	if _, err := WaitPipelineActive(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Pipeline (%s) create: %s", d.Id(), err)
+	}
+
 	return append(diags, resourcePipelineRead(ctx, d, meta)...)
 }
 
@@ -181,7 +191,10 @@ func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err := d.Set("parallelism_configuration", flattenParallelismConfiguration(pipeline.ParallelismConfiguration)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting parallelism_configuration: %s", err)
 	}
-	d.Set("pipeline_definition", pipeline.PipelineDefinition)
+	// When the definition is sourced from S3, DescribePipeline returns the resolved document.
+	if _, ok := d.GetOk("pipeline_definition_s3_location"); !ok {
+		d.Set("pipeline_definition", pipeline.PipelineDefinition)
+	}
 	d.Set("pipeline_description", pipeline.PipelineDescription)
 	d.Set("pipeline_display_name", pipeline.PipelineDisplayName)
 	d.Set("pipeline_name", pipeline.PipelineName)
@@ -228,6 +241,10 @@ func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating SageMaker Pipeline (%s): %s", d.Id(), err)
 		}
+
+		if _, err := WaitPipelineActive(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Pipeline (%s) update: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourcePipelineRead(ctx, d, meta)...)
@@ -42,6 +42,7 @@ const (
 	SpaceInServiceTimeout              = 10 * time.Minute
 	MonitoringScheduleScheduledTimeout = 2 * time.Minute
 	MonitoringScheduleStoppedTimeout   = 2 * time.Minute
+	PipelineActiveTimeout              = 5 * time.Minute
 )
 
 // WaitNotebookInstanceInService waits for a NotebookInstance to return InService
@@ -691,3 +692,21 @@ func WaitMonitoringScheduleNotFound(ctx context.Context, conn *sagemaker.SageMak
 
 	return nil, err
 }
+
+// WaitPipelineActive waits for a Pipeline to return Active
+func WaitPipelineActive(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribePipelineOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{},
+		Target:  []string{sagemaker.PipelineStatusActive},
+		Refresh: StatusPipeline(ctx, conn, name),
+		Timeout: PipelineActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribePipelineOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
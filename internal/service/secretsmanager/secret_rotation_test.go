@@ -122,6 +122,7 @@ func TestAccSecretsManagerSecretRotation_rotateImmediately(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "true"),
 					resource.TestCheckResourceAttrPair(resourceName, "rotation_lambda_arn", lambdaFunctionResourceName, "arn"),
 					resource.TestCheckResourceAttr(resourceName, "rotate_immediately", "false"),
+					testAccCheckSecretNotRotated(&secret),
 					resource.TestCheckResourceAttr(resourceName, "rotation_rules.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.automatically_after_days", strconv.Itoa(days)),
 					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.duration", ""),
@@ -430,6 +431,16 @@ func testAccCheckSecretRotationExists(ctx context.Context, n string, v *secretsm
 	}
 }
 
+func testAccCheckSecretNotRotated(v *secretsmanager.DescribeSecretOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v.LastRotatedDate != nil {
+			return fmt.Errorf("Secrets Manager Secret %s was rotated at %s", aws.ToString(v.ARN), aws.ToTime(v.LastRotatedDate))
+		}
+
+		return nil
+	}
+}
+
 func testSecretValueIsCurrent(ctx context.Context, rName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerClient(ctx)
@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalogappregistry
+
+// Exports for use in tests only.
+var (
+	ResourceApplication               = newResourceApplication
+	ResourceAttributeGroup            = newResourceAttributeGroup
+	ResourceAttributeGroupAssociation = newResourceAttributeGroupAssociation
+
+	FindApplicationByID                       = findApplicationByID
+	FindAttributeGroupByID                    = findAttributeGroupByID
+	FindAttributeGroupAssociationByTwoPartKey = findAttributeGroupAssociationByTwoPartKey
+)
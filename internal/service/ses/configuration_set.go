@@ -255,14 +255,38 @@ func resourceConfigurationSetUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if d.HasChange("tracking_options") {
-		input := &ses.UpdateConfigurationSetTrackingOptionsInput{
-			ConfigurationSetName: aws.String(d.Id()),
-			TrackingOptions:      expandConfigurationSetTrackingOptions(d.Get("tracking_options").([]interface{})),
-		}
-
-		_, err := conn.UpdateConfigurationSetTrackingOptionsWithContext(ctx, input)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating SES configuration set (%s) tracking options: %s", d.Id(), err)
+		o, n := d.GetChange("tracking_options")
+
+		switch oldOptions, newOptions := expandConfigurationSetTrackingOptions(o.([]interface{})), expandConfigurationSetTrackingOptions(n.([]interface{})); {
+		case newOptions == nil:
+			input := &ses.DeleteConfigurationSetTrackingOptionsInput{
+				ConfigurationSetName: aws.String(d.Id()),
+			}
+
+			_, err := conn.DeleteConfigurationSetTrackingOptionsWithContext(ctx, input)
+			if err != nil && !tfawserr.ErrCodeEquals(err, ses.ErrCodeTrackingOptionsDoesNotExistException) {
+				return sdkdiag.AppendErrorf(diags, "deleting SES configuration set (%s) tracking options: %s", d.Id(), err)
+			}
+		case oldOptions == nil:
+			input := &ses.CreateConfigurationSetTrackingOptionsInput{
+				ConfigurationSetName: aws.String(d.Id()),
+				TrackingOptions:      newOptions,
+			}
+
+			_, err := conn.CreateConfigurationSetTrackingOptionsWithContext(ctx, input)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating SES configuration set (%s) tracking options: %s", d.Id(), err)
+			}
+		default:
+			input := &ses.UpdateConfigurationSetTrackingOptionsInput{
+				ConfigurationSetName: aws.String(d.Id()),
+				TrackingOptions:      newOptions,
+			}
+
+			_, err := conn.UpdateConfigurationSetTrackingOptionsWithContext(ctx, input)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating SES configuration set (%s) tracking options: %s", d.Id(), err)
+			}
 		}
 	}
 
@@ -315,25 +315,28 @@ func TestAccSESConfigurationSet_Update_emptyDeliveryOptions(t *testing.T) {
 	})
 }
 
-/*
-// TestAccSESConfigurationSet_trackingOptions requires a verified domain
-// which poses a problem for testing.
 func TestAccSESConfigurationSet_trackingOptions(t *testing.T) {
+	ctx := acctest.Context(t)
+	// Custom redirect domains must be verified SES identities.
+	domain := acctest.SkipIfEnvVarNotSet(t, "SES_CUSTOM_REDIRECT_DOMAIN")
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ses_configuration_set.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
 		ErrorCheck:               acctest.ErrorCheck(t, ses.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckConfigurationSetDestroy,
+		CheckDestroy:             testAccCheckConfigurationSetDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccConfigurationSetConfig_trackingOptions(rName, "wn011su7.test"),
+				Config: testAccConfigurationSetConfig_trackingOptions(rName, domain),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckConfigurationSetExists(resourceName),
+					testAccCheckConfigurationSetExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "tracking_options.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "tracking_options.0.custom_redirect_domain", rName),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.0.custom_redirect_domain", domain),
 				),
 			},
 			{
@@ -341,10 +344,16 @@ func TestAccSESConfigurationSet_trackingOptions(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccConfigurationSetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.#", "0"),
+				),
+			},
 		},
 	})
 }
-*/
 
 func TestAccSESConfigurationSet_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -462,8 +471,6 @@ resource "aws_ses_configuration_set" "test" {
 `, rName, tlsPolicy)
 }
 
-/*
-// this cannot currently be tested without a verified domain
 func testAccConfigurationSetConfig_trackingOptions(rName, customRedirect string) string {
 	return fmt.Sprintf(`
 resource "aws_ses_configuration_set" "test" {
@@ -475,7 +482,6 @@ resource "aws_ses_configuration_set" "test" {
 }
 `, rName, customRedirect)
 }
-*/
 
 func testAccConfigurationSetConfig_emptyDeliveryOptions(rName string) string {
 	return fmt.Sprintf(`
@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -58,11 +59,15 @@ func ResourceParameter() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					"aws:ec2:image",
-					"aws:ssm:integration",
-					"text",
-				}, false),
+				// New AWS-managed data types are accepted without a provider change.
+				ValidateFunc: validation.Any(
+					validation.StringInSlice([]string{
+						"aws:ec2:image",
+						"aws:ssm:integration",
+						"text",
+					}, false),
+					validation.StringMatch(regexache.MustCompile(`^aws:[0-9a-z-]+:[0-9a-z-]+$`), "must be text or match aws:<service>:<type>"),
+				),
 			},
 			"description": {
 				Type:         schema.TypeString,
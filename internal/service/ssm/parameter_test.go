@@ -952,6 +952,28 @@ func TestAccSSMParameter_DataType_ec2Image(t *testing.T) {
 	})
 }
 
+func TestAccSSMParameter_DataType_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccParameterConfig_dataType(rName, "image"),
+				ExpectError: regexache.MustCompile(`must be text or match aws:<service>:<type>`),
+			},
+			{
+				Config:      testAccParameterConfig_dataType(rName, "aws:ec2"),
+				ExpectError: regexache.MustCompile(`must be text or match aws:<service>:<type>`),
+			},
+		},
+	})
+}
+
 func TestAccSSMParameter_DataType_ssmIntegration(t *testing.T) {
 	ctx := //nosemgrep:ci.ssm-in-func-name
 		acctest.Context(t)
@@ -1327,6 +1349,17 @@ resource "aws_ssm_parameter" "test" {
 `, rName))
 }
 
+func testAccParameterConfig_dataType(rName, dataType string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name      = %[1]q
+  data_type = %[2]q
+  type      = "String"
+  value     = "test"
+}
+`, rName, dataType)
+}
+
 func testAccParameterConfig_dataTypeSSMIntegration(rName string) string { // nosemgrep:ci.ssm-in-func-name
 	return acctest.ConfigCompose(
 		fmt.Sprintf(`
@@ -5,15 +5,18 @@ package verifiedpermissions
 
 // Exports for use in tests only.
 var (
+	ResourcePolicy         = newResourcePolicy
 	ResourcePolicyStore    = newResourcePolicyStore
 	ResourcePolicyTemplate = newResourcePolicyTemplate
 	ResourceSchema         = newResourceSchema
 
+	FindPolicyByID            = findPolicyByID
 	FindPolicyStoreByID       = findPolicyStoreByID
 	FindPolicyTemplateByID    = findPolicyTemplateByID
 	FindSchemaByPolicyStoreID = findSchemaByPolicyStoreID
 )
 
 var (
+	PolicyParseID         = policyParseID
 	PolicyTemplateParseID = policyTemplateParseID
 )
@@ -0,0 +1,483 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Policy")
+func newResourcePolicy(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourcePolicy{}
+
+	return r, nil
+}
+
+const (
+	ResNamePolicy = "Policy"
+)
+
+type resourcePolicy struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourcePolicy) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_policy"
+}
+
+func (r *resourcePolicy) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	entityIdentifierBlock := schema.SingleNestedBlock{
+		Attributes: map[string]schema.Attribute{
+			"entity_id": schema.StringAttribute{
+				Required: true,
+			},
+			"entity_type": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"created_date": schema.StringAttribute{
+				CustomType: fwtypes.TimestampType,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"policy_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.SingleNestedBlock{
+				Validators: []validator.Object{
+					objectvalidator.IsRequired(),
+				},
+				Blocks: map[string]schema.Block{
+					"static": schema.SingleNestedBlock{
+						Validators: []validator.Object{
+							objectvalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("static"),
+								path.MatchRelative().AtParent().AtName("template_linked"),
+							),
+						},
+						Attributes: map[string]schema.Attribute{
+							"description": schema.StringAttribute{
+								Optional: true,
+							},
+							"statement": schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+					"template_linked": schema.SingleNestedBlock{
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.RequiresReplace(),
+						},
+						Attributes: map[string]schema.Attribute{
+							"policy_template_id": schema.StringAttribute{
+								Required: true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"principal": entityIdentifierBlock,
+							"resource":  entityIdentifierBlock,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = s
+}
+
+func (r *resourcePolicy) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var plan resourcePolicyData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &verifiedpermissions.CreatePolicyInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		Definition:    expandPolicyDefinition(ctx, plan.Definition, &response.Diagnostics),
+		PolicyStoreId: flex.StringFromFramework(ctx, plan.PolicyStoreID),
+	}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreatePolicy(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNamePolicy, plan.PolicyStoreID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.ID = flex.StringValueToFramework(ctx, fmt.Sprintf("%s:%s", aws.ToString(output.PolicyStoreId), aws.ToString(output.PolicyId)))
+	state.CreatedDate = fwtypes.TimestampValue(aws.ToTime(output.CreatedDate).Format(time.RFC3339))
+	state.PolicyID = flex.StringToFramework(ctx, output.PolicyId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourcePolicy) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state resourcePolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID, policyID, err := policyParseID(state.ID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicy, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findPolicyByID(ctx, conn, policyStoreID, policyID)
+
+	if tfresource.NotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicy, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.CreatedDate = fwtypes.TimestampValue(aws.ToTime(output.CreatedDate).Format(time.RFC3339))
+	state.Definition = flattenPolicyDefinition(ctx, output.Definition)
+	state.PolicyID = flex.StringToFramework(ctx, output.PolicyId)
+	state.PolicyStoreID = flex.StringToFramework(ctx, output.PolicyStoreId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourcePolicy) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state, plan resourcePolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Only static policies can be updated in place; template-linked policy changes force replacement.
+	if !plan.Definition.Equal(state.Definition) {
+		input := &verifiedpermissions.UpdatePolicyInput{
+			Definition:    expandUpdatePolicyDefinition(ctx, plan.Definition, &response.Diagnostics),
+			PolicyId:      flex.StringFromFramework(ctx, state.PolicyID),
+			PolicyStoreId: flex.StringFromFramework(ctx, state.PolicyStoreID),
+		}
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdatePolicy(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNamePolicy, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourcePolicy) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	var state resourcePolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting Verified Permissions Policy", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	input := &verifiedpermissions.DeletePolicyInput{
+		PolicyId:      flex.StringFromFramework(ctx, state.PolicyID),
+		PolicyStoreId: flex.StringFromFramework(ctx, state.PolicyStoreID),
+	}
+
+	_, err := conn.DeletePolicy(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionDeleting, ResNamePolicy, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+type resourcePolicyData struct {
+	CreatedDate   fwtypes.Timestamp `tfsdk:"created_date"`
+	Definition    types.Object      `tfsdk:"definition"`
+	ID            types.String      `tfsdk:"id"`
+	PolicyID      types.String      `tfsdk:"policy_id"`
+	PolicyStoreID types.String      `tfsdk:"policy_store_id"`
+}
+
+type policyDefinitionData struct {
+	Static         types.Object `tfsdk:"static"`
+	TemplateLinked types.Object `tfsdk:"template_linked"`
+}
+
+type staticPolicyDefinitionData struct {
+	Description types.String `tfsdk:"description"`
+	Statement   types.String `tfsdk:"statement"`
+}
+
+type templateLinkedPolicyDefinitionData struct {
+	PolicyTemplateID types.String `tfsdk:"policy_template_id"`
+	Principal        types.Object `tfsdk:"principal"`
+	Resource         types.Object `tfsdk:"resource"`
+}
+
+type entityIdentifierData struct {
+	EntityID   types.String `tfsdk:"entity_id"`
+	EntityType types.String `tfsdk:"entity_type"`
+}
+
+func findPolicyByID(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, id string) (*verifiedpermissions.GetPolicyOutput, error) {
+	in := &verifiedpermissions.GetPolicyInput{
+		PolicyId:      aws.String(id),
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	out, err := conn.GetPolicy(ctx, in)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.PolicyId == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func policyParseID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%s), expected POLICY-STORE-ID:POLICY-ID", id)
+}
+
+func expandPolicyDefinition(ctx context.Context, object types.Object, diags *diag.Diagnostics) awstypes.PolicyDefinition {
+	var de policyDefinitionData
+	diags.Append(object.As(ctx, &de, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	if !de.Static.IsNull() {
+		var static staticPolicyDefinitionData
+		diags.Append(de.Static.As(ctx, &static, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil
+		}
+
+		return &awstypes.PolicyDefinitionMemberStatic{
+			Value: awstypes.StaticPolicyDefinition{
+				Description: flex.StringFromFramework(ctx, static.Description),
+				Statement:   flex.StringFromFramework(ctx, static.Statement),
+			},
+		}
+	}
+
+	if !de.TemplateLinked.IsNull() {
+		var templateLinked templateLinkedPolicyDefinitionData
+		diags.Append(de.TemplateLinked.As(ctx, &templateLinked, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil
+		}
+
+		return &awstypes.PolicyDefinitionMemberTemplateLinked{
+			Value: awstypes.TemplateLinkedPolicyDefinition{
+				PolicyTemplateId: flex.StringFromFramework(ctx, templateLinked.PolicyTemplateID),
+				Principal:        expandEntityIdentifier(ctx, templateLinked.Principal, diags),
+				Resource:         expandEntityIdentifier(ctx, templateLinked.Resource, diags),
+			},
+		}
+	}
+
+	return nil
+}
+
+func expandUpdatePolicyDefinition(ctx context.Context, object types.Object, diags *diag.Diagnostics) awstypes.UpdatePolicyDefinition {
+	var de policyDefinitionData
+	diags.Append(object.As(ctx, &de, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	var static staticPolicyDefinitionData
+	diags.Append(de.Static.As(ctx, &static, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return &awstypes.UpdatePolicyDefinitionMemberStatic{
+		Value: awstypes.UpdateStaticPolicyDefinition{
+			Description: flex.StringFromFramework(ctx, static.Description),
+			Statement:   flex.StringFromFramework(ctx, static.Statement),
+		},
+	}
+}
+
+func expandEntityIdentifier(ctx context.Context, object types.Object, diags *diag.Diagnostics) *awstypes.EntityIdentifier {
+	if object.IsNull() {
+		return nil
+	}
+
+	var ei entityIdentifierData
+	diags.Append(object.As(ctx, &ei, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return &awstypes.EntityIdentifier{
+		EntityId:   flex.StringFromFramework(ctx, ei.EntityID),
+		EntityType: flex.StringFromFramework(ctx, ei.EntityType),
+	}
+}
+
+func flattenPolicyDefinition(ctx context.Context, apiObject awstypes.PolicyDefinitionDetail) types.Object {
+	staticAttrTypes := fwtypes.AttributeTypesMust[staticPolicyDefinitionData](ctx)
+	entityIdentifierAttrTypes := fwtypes.AttributeTypesMust[entityIdentifierData](ctx)
+	// Reflection cannot determine the nested object attribute types.
+	templateLinkedAttrTypes := fwtypes.AttributeTypesMust[templateLinkedPolicyDefinitionData](ctx)
+	templateLinkedAttrTypes["principal"] = types.ObjectType{AttrTypes: entityIdentifierAttrTypes}
+	templateLinkedAttrTypes["resource"] = types.ObjectType{AttrTypes: entityIdentifierAttrTypes}
+	attributeTypes := fwtypes.AttributeTypesMust[policyDefinitionData](ctx)
+	attributeTypes["static"] = types.ObjectType{AttrTypes: staticAttrTypes}
+	attributeTypes["template_linked"] = types.ObjectType{AttrTypes: templateLinkedAttrTypes}
+
+	attrs := map[string]attr.Value{
+		"static":          types.ObjectNull(staticAttrTypes),
+		"template_linked": types.ObjectNull(templateLinkedAttrTypes),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.PolicyDefinitionDetailMemberStatic:
+		attrs["static"] = types.ObjectValueMust(staticAttrTypes, map[string]attr.Value{
+			"description": flex.StringToFramework(ctx, v.Value.Description),
+			"statement":   flex.StringToFramework(ctx, v.Value.Statement),
+		})
+	case *awstypes.PolicyDefinitionDetailMemberTemplateLinked:
+		attrs["template_linked"] = types.ObjectValueMust(templateLinkedAttrTypes, map[string]attr.Value{
+			"policy_template_id": flex.StringToFramework(ctx, v.Value.PolicyTemplateId),
+			"principal":          flattenEntityIdentifier(ctx, v.Value.Principal),
+			"resource":           flattenEntityIdentifier(ctx, v.Value.Resource),
+		})
+	default:
+		return types.ObjectNull(attributeTypes)
+	}
+
+	return types.ObjectValueMust(attributeTypes, attrs)
+}
+
+func flattenEntityIdentifier(ctx context.Context, apiObject *awstypes.EntityIdentifier) types.Object {
+	attributeTypes := fwtypes.AttributeTypesMust[entityIdentifierData](ctx)
+	if apiObject == nil {
+		return types.ObjectNull(attributeTypes)
+	}
+
+	attrs := map[string]attr.Value{
+		"entity_id":   flex.StringToFramework(ctx, apiObject.EntityId),
+		"entity_type": flex.StringToFramework(ctx, apiObject.EntityType),
+	}
+
+	return types.ObjectValueMust(attributeTypes, attrs)
+}
@@ -87,6 +87,7 @@ const (
 	SchedulerEndpointID                  = "scheduler"
 	SecretsManagerEndpointID             = "secretsmanager"
 	SecurityLakeEndpointID               = "securitylake"
+	ServiceCatalogAppRegistryEndpointID  = "servicecatalog-appregistry"
 	ServiceQuotasEndpointID              = "servicequotas"
 	S3EndpointID                         = "s3"
 	S3ControlEndpointID                  = "s3-control"